@@ -0,0 +1,68 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"mistapi/src/auth"
+	"mistapi/src/logging"
+	"mistapi/src/problem"
+	"mistapi/src/types"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+)
+
+func adminRouter(revocations *auth.Revocations, adminUserIDs []string) http.Handler {
+	r := chi.NewRouter()
+	r.Use(auth.RequireAdminMiddleware(adminUserIDs))
+
+	r.Post("/revocations", RevocationCreateHandler(revocations)) // revoke tokens
+	return r
+}
+
+// RevocationCreateHandler godoc
+// @Summary      Revoke tokens
+// @Description  Revoke a single token by jti, or every token of a user issued before issued_before (defaults to now), only admins can perform this action
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        revocation  body      types.RevocationCreate  true  "RevocationCreate"
+// @Success      204
+// @Router       /api/v1/admin/revocations [post]
+func RevocationCreateHandler(revocations *auth.Revocations) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		rev, err := DecodeRequestBody[types.RevocationCreate](w, r)
+		if err != nil {
+			return
+		}
+
+		if (rev.Jti == "") == (rev.UserId == "") {
+			RenderError(w, r, http.StatusBadRequest, problem.BadRequest, "Exactly one of jti and user_id is required.")
+			return
+		}
+
+		logger := logging.FromContext(r.Context())
+		if rev.Jti != "" {
+			err = revocations.RevokeToken(r.Context(), rev.Jti)
+			logger = logger.With("revoked_jti", rev.Jti)
+		} else {
+			issuedBefore := time.Now()
+			if rev.IssuedBefore != nil {
+				issuedBefore = *rev.IssuedBefore
+			}
+			err = revocations.RevokeUser(r.Context(), rev.UserId, issuedBefore)
+			logger = logger.With("revoked_user_id", rev.UserId, "issued_before", issuedBefore)
+		}
+
+		if err != nil {
+			logger.Error("Revoking tokens failed", "error", err)
+			RenderError(w, r, http.StatusInternalServerError, problem.Internal, "Internal Server Error.")
+			return
+		}
+
+		logger.Info("Tokens revoked")
+		render.NoContent(w, r)
+	}
+}
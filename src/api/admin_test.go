@@ -0,0 +1,82 @@
+package api_test
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"mistapi/src/api"
+	"mistapi/src/auth"
+	"mistapi/src/problem"
+	"mistapi/src/types"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevocationCreate(t *testing.T) {
+	log.SetOutput(new(strings.Builder))
+
+	url := "/api/v1/admin/revocations"
+	ctx := context.Background()
+
+	t.Run("Success:revokes_a_token_by_jti", func(t *testing.T) {
+		// ARRANGE
+		revocations := auth.NewRevocations(auth.NewMemoryStore(), time.Hour)
+		req, err := http.NewRequest(http.MethodPost, url, marshallPayload(t, types.RevocationCreate{Jti: "jti-1"}))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+
+		// ACT
+		api.RevocationCreateHandler(revocations)(rr, req)
+
+		// ASSERT
+		assert.Equal(t, http.StatusNoContent, rr.Code)
+		err = revocations.Check(ctx, &auth.CustomJWTClaims{RegisteredClaims: jwt.RegisteredClaims{ID: "jti-1"}})
+		assert.ErrorIs(t, err, auth.ErrTokenRevoked)
+	})
+
+	t.Run("Success:revokes_user_tokens_issued_before_now", func(t *testing.T) {
+		// ARRANGE
+		revocations := auth.NewRevocations(auth.NewMemoryStore(), time.Hour)
+		req, err := http.NewRequest(http.MethodPost, url, marshallPayload(t, types.RevocationCreate{UserId: "123"}))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+
+		// ACT
+		api.RevocationCreateHandler(revocations)(rr, req)
+
+		// ASSERT
+		assert.Equal(t, http.StatusNoContent, rr.Code)
+		err = revocations.Check(ctx, &auth.CustomJWTClaims{
+			RegisteredClaims: jwt.RegisteredClaims{IssuedAt: jwt.NewNumericDate(time.Now().Add(-time.Minute))},
+			UserID:           "123",
+		})
+		assert.ErrorIs(t, err, auth.ErrTokenRevoked)
+	})
+
+	t.Run("Error:jti_and_user_id_are_mutually_exclusive", func(t *testing.T) {
+		// ARRANGE
+		revocations := auth.NewRevocations(auth.NewMemoryStore(), time.Hour)
+		expected := marshallResponse(t, problem.New(http.StatusBadRequest, problem.BadRequest, "Exactly one of jti and user_id is required."))
+		req, err := http.NewRequest(http.MethodPost, url,
+			marshallPayload(t, types.RevocationCreate{Jti: "jti-1", UserId: "123"}))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+
+		// ACT
+		api.RevocationCreateHandler(revocations)(rr, req)
+
+		// ASSERT
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.JSONEq(t, expected, rr.Body.String())
+	})
+}
@@ -4,6 +4,9 @@ import (
 	"net/http"
 
 	"mistapi/src/auth"
+	"mistapi/src/events"
+	"mistapi/src/permissions"
+	"mistapi/src/problem"
 	"mistapi/src/protos/v1/appserver"
 	"mistapi/src/protos/v1/appserver_role"
 	"mistapi/src/protos/v1/appserver_role_sub"
@@ -17,21 +20,34 @@ import (
 	"github.com/go-chi/render"
 )
 
-func appserverRouter() http.Handler {
+func appserverRouter(broker *events.Broker) http.Handler {
 	r := chi.NewRouter()
 
 	r.Post("/", AppserverCreateHandler) // create an appserver
 	r.Get("/", AppserverListHandler)    // list all existing servers (most likely to be deprecated)
 
-	r.Get("/{id}", AppserverDetailHandler)                                     // get all appserver details
-	r.Get("/{id}/channels", AppserverListChannelsHandler)                      // get all channels in a server
-	r.Get("/{sid}/channels/{cid}/channel-roles", AppserverChannelRolesHandler) // get all channel roles in a server
-	r.Get("/{id}/subs", AppserverListSubsHandler)                              // get all appserver user subscriptions
-	r.Get("/{id}/roles", AppserverListRolesHandler)                            // get all appserver roles
-	r.Get("/{id}/role-subs", AppserverListRoleSubHandler)                      // get all appservers' role subscriptions
-
-	r.Delete("/{id}", AppserverDeleteHandler)              // delete an appserver
-	r.Delete("/{id}/channels/{cid}", ChannelDeleteHandler) // delete a channel
+	// IDs in the path are checked before permissions are loaded
+	r.Group(func(r chi.Router) {
+		r.Use(ValidatePathParams)
+
+		r.Get("/{id}", AppserverDetailHandler)                                         // get all appserver details
+		r.Get("/{id}/channels", AppserverListChannelsHandler)                          // get all channels in a server
+		r.Get("/{sid}/channels/{cid}", AppserverChannelDetailHandler)                  // get a channel and its roles
+		r.Get("/{sid}/channels/{cid}/channel-roles", AppserverChannelRolesHandler)     // get all channel roles in a server
+		r.Get("/{id}/subs", AppserverListSubsHandler)                                  // get all appserver user subscriptions
+		r.Get("/{id}/roles", AppserverListRolesHandler)                                // get all appserver roles
+		r.Get("/{id}/role-subs", AppserverListRoleSubHandler)                          // get all appservers' role subscriptions
+		r.Get("/{id}/members/{userId}/permissions", AppserverMemberPermissionsHandler) // get a member's permissions
+		r.Get("/{id}/me/permissions", AppserverMyPermissionsHandler)                   // get the caller's permissions
+		r.Get("/{id}/events", AppserverEventsHandler(broker))                          // stream the appserver events
+
+		r.With(requirePermissions(permissions.URLParam("id"), permissions.ManageAppserver)).
+			Patch("/{id}", AppserverUpdateHandler) // update an appserver
+		r.With(requirePermissions(permissions.URLParam("id"), permissions.ManageAppserver)).
+			Delete("/{id}", AppserverDeleteHandler) // delete an appserver
+		r.With(requirePermissions(permissions.URLParam("id"), permissions.ManageChannels)).
+			Delete("/{id}/channels/{cid}", ChannelDeleteHandler) // delete a channel
+	})
 
 	return r
 }
@@ -47,23 +63,24 @@ func appserverRouter() http.Handler {
 // @Success      201 {object} types.Appserver
 // @Router       /api/v1/appservers [post]
 func AppserverCreateHandler(w http.ResponseWriter, r *http.Request) {
-	var s types.AppserverCreate
-
-	err := DecodeRequestBody(w, r, &s)
+	s, err := DecodeRequestBody[types.AppserverCreate](w, r)
 	if err != nil {
 		return
 	}
 
+	req := &appserver.CreateRequest{
+		Name: s.Name,
+	}
+	if !validateRequest(w, r, req) {
+		return
+	}
+
 	authT, _ := auth.GetAuthotizationToken(r)
-	ctx, cancel := service.SetupGrpcHeaders(authT.Token)
+	ctx, cancel := service.SetupGrpcHeaders(r.Context(), authT.Token, BackendTimeout(r))
 	defer cancel()
 
 	c := service.NewGrpcClient()
-	response, err := c.GetAppserverClient().Create(
-		ctx, &appserver.CreateRequest{
-			Name: s.Name,
-		},
-	)
+	response, err := c.GetAppserverClient().Create(ctx, req)
 
 	if err != nil {
 		HandleGrpcError(w, r, err)
@@ -80,17 +97,39 @@ func AppserverCreateHandler(w http.ResponseWriter, r *http.Request) {
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
+// @Param        name    query     string  false  "Only appservers whose name contains it, case-insensitive"
+// @Param        limit   query     int     false  "Page size, 1 to 100, default 50"
+// @Param        cursor  query     string  false  "next_cursor of the previous page"
 // @Success      200  {array}  types.Appserver
+// @Failure      400  {object}  ErrorResponse
 // @Router       /api/v1/appservers [get]
 func AppserverListHandler(w http.ResponseWriter, r *http.Request) {
+	filters := newListFilters(r)
+	name := filters.text("name")
+	if !filters.done(w, r) {
+		return
+	}
+
+	p, ok := parsePage(w, r, filters.list("appservers"))
+	if !ok {
+		return
+	}
+
+	req := &appserver_sub.ListUserServerSubsRequest{
+		PageSize:  p.size,
+		PageToken: p.token,
+		Name:      name,
+	}
+	if !validateRequest(w, r, req) {
+		return
+	}
+
 	authT, _ := auth.GetAuthotizationToken(r)
-	ctx, cancel := service.SetupGrpcHeaders(authT.Token)
+	ctx, cancel := service.SetupGrpcHeaders(r.Context(), authT.Token, BackendTimeout(r))
 	defer cancel()
 
 	c := service.NewGrpcClient()
-	response, err := c.GetAppserverSubClient().ListUserServerSubs(
-		ctx, &appserver_sub.ListUserServerSubsRequest{},
-	)
+	response, err := c.GetAppserverSubClient().ListUserServerSubs(ctx, req)
 
 	if err != nil {
 		HandleGrpcError(w, r, err)
@@ -101,16 +140,12 @@ func AppserverListHandler(w http.ResponseWriter, r *http.Request) {
 
 	for _, a := range response.Appservers {
 		res = append(res, types.AppserverAndSub{
-			Appserver: types.Appserver{
-				ID:      a.Appserver.Id,
-				Name:    a.Appserver.Name,
-				IsOwner: a.Appserver.IsOwner,
-			},
-			SubId: a.SubId,
+			Appserver: appserverFromProto(a.Appserver),
+			SubId:     a.SubId,
 		})
 	}
 
-	render.JSON(w, r, CreateResponse(res))
+	render.JSON(w, r, CreatePageResponse(r, p, res, response.NextPageToken))
 }
 
 // AppserverDetailHandler godoc
@@ -126,16 +161,19 @@ func AppserverListHandler(w http.ResponseWriter, r *http.Request) {
 func AppserverDetailHandler(w http.ResponseWriter, r *http.Request) {
 	sId := chi.URLParam(r, "id")
 
+	req := &appserver.GetByIdRequest{
+		Id: sId,
+	}
+	if !validateRequest(w, r, req) {
+		return
+	}
+
 	authT, _ := auth.GetAuthotizationToken(r)
-	ctx, cancel := service.SetupGrpcHeaders(authT.Token)
+	ctx, cancel := service.SetupGrpcHeaders(r.Context(), authT.Token, BackendTimeout(r))
 	defer cancel()
 
 	c := service.NewGrpcClient()
-	response, err := c.GetAppserverClient().GetById(
-		ctx, &appserver.GetByIdRequest{
-			Id: sId,
-		},
-	)
+	response, err := c.GetAppserverClient().GetById(ctx, req)
 
 	if err != nil {
 		HandleGrpcError(w, r, err)
@@ -156,11 +194,7 @@ func AppserverDetailHandler(w http.ResponseWriter, r *http.Request) {
 	roles := make([]types.AppserverRole, 0, len(rolesResponse.AppserverRoles))
 
 	for _, role := range rolesResponse.AppserverRoles {
-		roles = append(roles, types.AppserverRole{
-			ID:          role.Id,
-			Name:        role.Name,
-			AppserverId: role.AppserverId,
-		})
+		roles = append(roles, appserverRoleFromProto(role))
 	}
 
 	channelResponse, err := c.GetChannelClient().ListServerChannels(
@@ -178,19 +212,16 @@ func AppserverDetailHandler(w http.ResponseWriter, r *http.Request) {
 	channels := make([]types.Channel, 0, len(channelResponse.Channels))
 
 	for _, c := range channelResponse.Channels {
-		channels = append(channels, types.Channel{
-			ID:          c.Id,
-			Name:        c.Name,
-			AppserverId: c.AppserverId,
-		})
+		channels = append(channels, channelFromProto(c))
 	}
 
 	render.JSON(w, r, CreateResponse(&types.AppserverDetail{
-		ID:       response.Appserver.Id,
-		Name:     response.Appserver.Name,
-		IsOwner:  response.Appserver.IsOwner,
-		Roles:    roles,
-		Channels: channels,
+		ID:        response.Appserver.Id,
+		Name:      response.Appserver.Name,
+		IsOwner:   response.Appserver.IsOwner,
+		UpdatedAt: protoTime(response.Appserver.UpdatedAt),
+		Roles:     roles,
+		Channels:  channels,
 	}))
 }
 
@@ -200,23 +231,43 @@ func AppserverDetailHandler(w http.ResponseWriter, r *http.Request) {
 // @Tags         appserver
 // @Accept       json
 // @Produce      json
-// @Param        id   path      string  true  "Appserver ID"
+// @Param        id      path      string  true   "Appserver ID"
+// @Param        q       query     string  false  "Only members whose username contains it, case-insensitive"
+// @Param        limit   query     int     false  "Page size, 1 to 100, default 50"
+// @Param        cursor  query     string  false  "next_cursor of the previous page"
 // @Security     BearerAuth
 // @Success      200 {array} types.AppuserAppserverSub
+// @Failure      400 {object} ErrorResponse
 // @Router       /api/v1/appservers/{id}/subs [get]
 func AppserverListSubsHandler(w http.ResponseWriter, r *http.Request) {
 	sId := chi.URLParam(r, "id")
+	filters := newListFilters(r)
+	q := filters.text("q")
+	if !filters.done(w, r) {
+		return
+	}
+
+	p, ok := parsePage(w, r, filters.list("appservers/"+sId+"/subs"))
+	if !ok {
+		return
+	}
+
+	req := &appserver_sub.ListAppserverUserSubsRequest{
+		AppserverId: sId,
+		PageSize:    p.size,
+		PageToken:   p.token,
+		Q:           q,
+	}
+	if !validateRequest(w, r, req) {
+		return
+	}
 
 	authT, _ := auth.GetAuthotizationToken(r)
-	ctx, cancel := service.SetupGrpcHeaders(authT.Token)
+	ctx, cancel := service.SetupGrpcHeaders(r.Context(), authT.Token, BackendTimeout(r))
 	defer cancel()
 
 	c := service.NewGrpcClient()
-	response, err := c.GetAppserverSubClient().ListAppserverUserSubs(
-		ctx, &appserver_sub.ListAppserverUserSubsRequest{
-			AppserverId: sId,
-		},
-	)
+	response, err := c.GetAppserverSubClient().ListAppserverUserSubs(ctx, req)
 
 	if err != nil {
 		HandleGrpcError(w, r, err)
@@ -232,7 +283,7 @@ func AppserverListSubsHandler(w http.ResponseWriter, r *http.Request) {
 		})
 	}
 
-	render.JSON(w, r, CreateResponse(subs))
+	render.JSON(w, r, CreatePageResponse(r, p, subs, response.NextPageToken))
 }
 
 // AppserverListSubsHandler godoc
@@ -241,23 +292,34 @@ func AppserverListSubsHandler(w http.ResponseWriter, r *http.Request) {
 // @Tags         appserver
 // @Accept       json
 // @Produce      json
-// @Param        id   path      string  true  "Appserver ID"
+// @Param        id      path      string  true   "Appserver ID"
+// @Param        limit   query     int     false  "Page size, 1 to 100, default 50"
+// @Param        cursor  query     string  false  "next_cursor of the previous page"
 // @Security     BearerAuth
 // @Success      200 {array} types.AppserverRole
 // @Router       /api/v1/appservers/{id}/roles [get]
 func AppserverListRolesHandler(w http.ResponseWriter, r *http.Request) {
 	sId := chi.URLParam(r, "id")
+	p, ok := parsePage(w, r, "appservers/"+sId+"/roles")
+	if !ok {
+		return
+	}
+
+	req := &appserver_role.ListServerRolesRequest{
+		AppserverId: sId,
+		PageSize:    p.size,
+		PageToken:   p.token,
+	}
+	if !validateRequest(w, r, req) {
+		return
+	}
 
 	authT, _ := auth.GetAuthotizationToken(r)
-	ctx, cancel := service.SetupGrpcHeaders(authT.Token)
+	ctx, cancel := service.SetupGrpcHeaders(r.Context(), authT.Token, BackendTimeout(r))
 	defer cancel()
 
 	c := service.NewGrpcClient()
-	response, err := c.GetAppserverRoleClient().ListServerRoles(
-		ctx, &appserver_role.ListServerRolesRequest{
-			AppserverId: sId,
-		},
-	)
+	response, err := c.GetAppserverRoleClient().ListServerRoles(ctx, req)
 
 	if err != nil {
 		HandleGrpcError(w, r, err)
@@ -267,14 +329,10 @@ func AppserverListRolesHandler(w http.ResponseWriter, r *http.Request) {
 	roles := make([]types.AppserverRole, 0, len(response.AppserverRoles))
 
 	for _, role := range response.AppserverRoles {
-		roles = append(roles, types.AppserverRole{
-			ID:          role.Id,
-			Name:        role.Name,
-			AppserverId: role.AppserverId,
-		})
+		roles = append(roles, appserverRoleFromProto(role))
 	}
 
-	render.JSON(w, r, CreateResponse(roles))
+	render.JSON(w, r, CreatePageResponse(r, p, roles, response.NextPageToken))
 }
 
 // AppserverListRoleSubHandler godoc
@@ -284,22 +342,42 @@ func AppserverListRolesHandler(w http.ResponseWriter, r *http.Request) {
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
-// @Param        id  path  string  true  "Appserver ID"
+// @Param        id       path      string  true   "Appserver ID"
+// @Param        role_id  query     string  false  "Only the subs to this role"
+// @Param        limit    query     int     false  "Page size, 1 to 100, default 50"
+// @Param        cursor   query     string  false  "next_cursor of the previous page"
 // @Success      200  {array}  types.AppserverRoleSub
+// @Failure      400  {object}  ErrorResponse
 // @Router       /api/v1/appservers/{id}/appserver-role-subs [get]
 func AppserverListRoleSubHandler(w http.ResponseWriter, r *http.Request) {
 	sId := chi.URLParam(r, "id")
+	filters := newListFilters(r)
+	roleId := filters.text("role_id")
+	if !filters.done(w, r) {
+		return
+	}
+
+	p, ok := parsePage(w, r, filters.list("appservers/"+sId+"/role-subs"))
+	if !ok {
+		return
+	}
+
+	req := &appserver_role_sub.ListServerRoleSubsRequest{
+		AppserverId:     sId,
+		PageSize:        p.size,
+		PageToken:       p.token,
+		AppserverRoleId: roleId,
+	}
+	if !validateRequest(w, r, req) {
+		return
+	}
 
 	authT, _ := auth.GetAuthotizationToken(r)
-	ctx, cancel := service.SetupGrpcHeaders(authT.Token)
+	ctx, cancel := service.SetupGrpcHeaders(r.Context(), authT.Token, BackendTimeout(r))
 	defer cancel()
 
 	c := service.NewGrpcClient()
-	response, err := c.GetAppserverRoleSubClient().ListServerRoleSubs(
-		ctx, &appserver_role_sub.ListServerRoleSubsRequest{
-			AppserverId: sId,
-		},
-	)
+	response, err := c.GetAppserverRoleSubClient().ListServerRoleSubs(ctx, req)
 
 	if err != nil {
 		HandleGrpcError(w, r, err)
@@ -317,7 +395,7 @@ func AppserverListRoleSubHandler(w http.ResponseWriter, r *http.Request) {
 		})
 	}
 
-	render.JSON(w, r, CreateResponse(res))
+	render.JSON(w, r, CreatePageResponse(r, p, res, response.NextPageToken))
 }
 
 // AppserverListChannelsHandler godoc
@@ -327,7 +405,11 @@ func AppserverListRoleSubHandler(w http.ResponseWriter, r *http.Request) {
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
-// @Param        id  path      string  true  "Appserver ID"
+// @Param        id          path      string  true   "Appserver ID"
+// @Param        name        query     string  false  "Only channels whose name contains it, case-insensitive"
+// @Param        is_private  query     bool    false  "Only private or only public channels"
+// @Param        limit       query     int     false  "Page size, 1 to 100, default 50"
+// @Param        cursor      query     string  false  "next_cursor of the previous page"
 // @Success      200          {array}   types.AppserverSub
 // @Failure      400          {object}  ErrorResponse "Invalid appserver ID"
 // @Failure      500          {object}  ErrorResponse "Internal Server Error"
@@ -336,19 +418,37 @@ func AppserverListChannelsHandler(w http.ResponseWriter, r *http.Request) {
 	// Extract the appserver ID from URL parameters
 
 	sId := chi.URLParam(r, "id")
+	filters := newListFilters(r)
+	name := filters.text("name")
+	isPrivate := filters.boolean("is_private")
+	if !filters.done(w, r) {
+		return
+	}
+
+	p, ok := parsePage(w, r, filters.list("appservers/"+sId+"/channels"))
+	if !ok {
+		return
+	}
 
 	// Authorization and gRPC context setup
+	req := &channel.ListServerChannelsRequest{
+		AppserverId: sId,
+		PageSize:    p.size,
+		PageToken:   p.token,
+		Name:        name,
+		IsPrivate:   isPrivate,
+	}
+	if !validateRequest(w, r, req) {
+		return
+	}
+
 	authT, _ := auth.GetAuthotizationToken(r)
-	ctx, cancel := service.SetupGrpcHeaders(authT.Token)
+	ctx, cancel := service.SetupGrpcHeaders(r.Context(), authT.Token, BackendTimeout(r))
 	defer cancel()
 
 	// Create a new gRPC client and make the request to list channels for the appserver
 	c := service.NewGrpcClient()
-	response, err := c.GetChannelClient().ListServerChannels(
-		ctx, &channel.ListServerChannelsRequest{
-			AppserverId: sId,
-		},
-	)
+	response, err := c.GetChannelClient().ListServerChannels(ctx, req)
 
 	if err != nil {
 		// Handle gRPC error and return it as a response
@@ -359,14 +459,63 @@ func AppserverListChannelsHandler(w http.ResponseWriter, r *http.Request) {
 	channels := make([]types.Channel, 0, len(response.Channels))
 
 	for _, c := range response.Channels {
-		channels = append(channels, types.Channel{
-			ID:          c.Id,
-			Name:        c.Name,
-			AppserverId: c.AppserverId,
-		})
+		channels = append(channels, channelFromProto(c))
 	}
 	// Successfully fetched channels, return them in the response
-	render.JSON(w, r, CreateResponse(channels))
+	render.JSON(w, r, CreatePageResponse(r, p, channels, response.NextPageToken))
+}
+
+// AppserverUpdateHandler godoc
+// @Summary      Update an appserver
+// @Description  Apply a JSON merge patch to an appserver. When updated_at is given the update only
+// @Description  succeeds if the appserver has not changed since, otherwise 412 is returned.
+// @Tags         appserver
+// @Accept       json
+// @Produce      json
+// @Param        id     path      string                true  "Appserver ID"
+// @Param        patch  body      types.AppserverPatch  true  "AppserverPatch"
+// @Security     BearerAuth
+// @Success      200 {object} types.Appserver
+// @Failure      400 {object} ErrorResponse
+// @Failure      412 {object} ErrorResponse
+// @Router       /api/v1/appservers/{id} [patch]
+func AppserverUpdateHandler(w http.ResponseWriter, r *http.Request) {
+	sId := chi.URLParam(r, "id")
+
+	patch, ok := decodeMergePatch(w, r)
+	if !ok {
+		return
+	}
+
+	s := &appserver.Appserver{Id: sId}
+	patch.name("name", &s.Name, "name")
+	updatedAt := patch.updatedAt()
+	if !patch.done(w, r) {
+		return
+	}
+
+	req := &appserver.UpdateRequest{
+		Appserver:         s,
+		UpdateMask:        patch.mask(),
+		ExpectedUpdatedAt: updatedAt,
+	}
+	if !validateRequest(w, r, req) {
+		return
+	}
+
+	authT, _ := auth.GetAuthotizationToken(r)
+	ctx, cancel := service.SetupGrpcHeaders(r.Context(), authT.Token, BackendTimeout(r))
+	defer cancel()
+
+	c := service.NewGrpcClient()
+	response, err := c.GetAppserverClient().Update(ctx, req)
+
+	if err != nil {
+		HandleGrpcError(w, r, err)
+		return
+	}
+
+	render.JSON(w, r, CreateResponse(appserverFromProto(response.Appserver)))
 }
 
 // AppserverDeleteHandler godoc
@@ -382,16 +531,19 @@ func AppserverListChannelsHandler(w http.ResponseWriter, r *http.Request) {
 func AppserverDeleteHandler(w http.ResponseWriter, r *http.Request) {
 	sId := chi.URLParam(r, "id")
 
+	req := &appserver.DeleteRequest{
+		Id: sId,
+	}
+	if !validateRequest(w, r, req) {
+		return
+	}
+
 	authT, _ := auth.GetAuthotizationToken(r)
-	ctx, cancel := service.SetupGrpcHeaders(authT.Token)
+	ctx, cancel := service.SetupGrpcHeaders(r.Context(), authT.Token, BackendTimeout(r))
 	defer cancel()
 
 	c := service.NewGrpcClient()
-	_, err := c.GetAppserverClient().Delete(
-		ctx, &appserver.DeleteRequest{
-			Id: sId,
-		},
-	)
+	_, err := c.GetAppserverClient().Delete(ctx, req)
 
 	if err != nil {
 		HandleGrpcError(w, r, err)
@@ -408,20 +560,94 @@ func AppserverDeleteHandler(w http.ResponseWriter, r *http.Request) {
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
-// @Param        channel_id    path  string  true  "Channel ID"
-// @Param        appserver_id  path  string  true  "Appserver ID"
+// @Param        cid     path   string  true   "Channel ID"
+// @Param        sid     path   string  true   "Appserver ID"
+// @Param        limit   query  int     false  "Page size, 1 to 100, default 50"
+// @Param        cursor  query  string  false  "next_cursor of the previous page"
 // @Success      200  {array}  types.ChannelRole
 // @Router       /api/v1/appservers/{sid}/channels/{cid}/channel-roles [get]
 func AppserverChannelRolesHandler(w http.ResponseWriter, r *http.Request) {
 	channelID := chi.URLParam(r, "cid")
 	sId := chi.URLParam(r, "sid")
+	p, ok := parsePage(w, r, "appservers/"+sId+"/channels/"+channelID+"/channel-roles")
+	if !ok {
+		return
+	}
+
+	req := &channel_role.ListChannelRolesRequest{
+		ChannelId:   channelID,
+		AppserverId: sId,
+		PageSize:    p.size,
+		PageToken:   p.token,
+	}
+	if !validateRequest(w, r, req) {
+		return
+	}
+
+	authT, _ := auth.GetAuthotizationToken(r)
+	ctx, cancel := service.SetupGrpcHeaders(r.Context(), authT.Token, BackendTimeout(r))
+	defer cancel()
+
+	c := service.NewGrpcClient()
+	res, err := c.GetChannelRoleClient().ListChannelRoles(ctx, req)
+
+	if err != nil {
+		HandleGrpcError(w, r, err)
+		return
+	}
+
+	response := make([]types.ChannelRole, 0, len(res.ChannelRoles))
+	for _, r := range res.ChannelRoles {
+		response = append(response, channelRoleFromProto(r))
+	}
+
+	render.JSON(w, r, CreatePageResponse(r, p, response, res.NextPageToken))
+}
+
+// AppserverChannelDetailHandler godoc
+// @Summary      Get a channel of an appserver
+// @Description  Get a single channel with the channel roles mapped to it
+// @Tags         channel
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        sid  path  string  true  "Appserver ID"
+// @Param        cid  path  string  true  "Channel ID"
+// @Success      200  {object}  types.ChannelDetail
+// @Failure      404  {object}  ErrorResponse
+// @Router       /api/v1/appservers/{sid}/channels/{cid} [get]
+func AppserverChannelDetailHandler(w http.ResponseWriter, r *http.Request) {
+	channelID := chi.URLParam(r, "cid")
+	sId := chi.URLParam(r, "sid")
+
+	req := &channel.GetByIdRequest{
+		Id:          channelID,
+		AppserverId: sId,
+	}
+	if !validateRequest(w, r, req) {
+		return
+	}
 
 	authT, _ := auth.GetAuthotizationToken(r)
-	ctx, cancel := service.SetupGrpcHeaders(authT.Token)
+	ctx, cancel := service.SetupGrpcHeaders(r.Context(), authT.Token, BackendTimeout(r))
 	defer cancel()
 
 	c := service.NewGrpcClient()
-	res, err := c.GetChannelRoleClient().ListChannelRoles(
+	response, err := c.GetChannelClient().GetById(ctx, req)
+
+	if err != nil {
+		HandleGrpcError(w, r, err)
+		return
+	}
+
+	ch := response.Channel
+	if ch == nil || ch.AppserverId != sId {
+		// a channel is only reachable through its own appserver
+		RenderError(w, r, http.StatusNotFound, problem.NotFound, "Not found.")
+		return
+	}
+
+	rolesResponse, err := c.GetChannelRoleClient().ListChannelRoles(
 		ctx, &channel_role.ListChannelRolesRequest{
 			ChannelId:   channelID,
 			AppserverId: sId,
@@ -433,17 +659,20 @@ func AppserverChannelRolesHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	response := make([]types.ChannelRole, 0, len(res.ChannelRoles))
-	for _, r := range res.ChannelRoles {
-		response = append(response, types.ChannelRole{
-			ID:              r.Id,
-			ChannelId:       r.ChannelId,
-			AppserverId:     r.AppserverId,
-			AppserverRoleId: r.AppserverRoleId,
-		})
+	roles := make([]types.ChannelRole, 0, len(rolesResponse.ChannelRoles))
+	for _, role := range rolesResponse.ChannelRoles {
+		roles = append(roles, channelRoleFromProto(role))
 	}
 
-	render.JSON(w, r, CreateResponse(response))
+	render.JSON(w, r, CreateResponse(&types.ChannelDetail{
+		ID:          ch.Id,
+		Name:        ch.Name,
+		AppserverId: ch.AppserverId,
+		IsPrivate:   ch.IsPrivate,
+		CreatedAt:   protoTime(ch.CreatedAt),
+		UpdatedAt:   protoTime(ch.UpdatedAt),
+		Roles:       roles,
+	}))
 }
 
 // ChannelDeleteHandler godoc
@@ -460,22 +689,49 @@ func ChannelDeleteHandler(w http.ResponseWriter, r *http.Request) {
 	sId := chi.URLParam(r, "id")
 	cId := chi.URLParam(r, "cid")
 
+	req := &channel.DeleteRequest{
+		Id:          cId,
+		AppserverId: sId,
+	}
+	if !validateRequest(w, r, req) {
+		return
+	}
+
 	authT, _ := auth.GetAuthotizationToken(r)
-	ctx, cancel := service.SetupGrpcHeaders(authT.Token)
+	ctx, cancel := service.SetupGrpcHeaders(r.Context(), authT.Token, BackendTimeout(r))
 	defer cancel()
 
 	c := service.NewGrpcClient()
-	_, err := c.GetChannelClient().Delete(
-		ctx, &channel.DeleteRequest{
-			Id:          cId,
-			AppserverId: sId,
-		},
-	)
+	_, err := c.GetChannelClient().Delete(ctx, req)
 
 	if err != nil {
 		HandleGrpcError(w, r, err)
 		return
 	}
 
+	events.Publish(r.Context(), events.Event{
+		Type:        events.ChannelDeleted,
+		AppserverID: sId,
+		ChannelID:   cId,
+	})
+
 	render.NoContent(w, r)
 }
+
+func appserverFromProto(s *appserver.Appserver) types.Appserver {
+	return types.Appserver{
+		ID:        s.Id,
+		Name:      s.Name,
+		IsOwner:   s.IsOwner,
+		UpdatedAt: protoTime(s.UpdatedAt),
+	}
+}
+
+func channelRoleFromProto(r *channel_role.ChannelRole) types.ChannelRole {
+	return types.ChannelRole{
+		ID:              r.Id,
+		ChannelId:       r.ChannelId,
+		AppserverId:     r.AppserverId,
+		AppserverRoleId: r.AppserverRoleId,
+	}
+}
@@ -0,0 +1,163 @@
+package api
+
+import (
+	"net/http"
+
+	"mistapi/src/auth"
+	"mistapi/src/permissions"
+	"mistapi/src/protos/v1/appserver"
+	"mistapi/src/protos/v1/appserver_role"
+	"mistapi/src/protos/v1/appserver_role_sub"
+	"mistapi/src/protos/v1/channel"
+	"mistapi/src/protos/v1/channel_role"
+	"mistapi/src/service"
+	"mistapi/src/types"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+)
+
+// AppserverMemberPermissionsHandler godoc
+// @Summary      Get the permissions of a member
+// @Description  Resolve the permissions of a member in the appserver and each of its channels, with the roles granting them. Ownership is only known for the caller, see /me/permissions
+// @Tags         appserver
+// @Accept       json
+// @Produce      json
+// @Param        id      path      string  true  "Appserver ID"
+// @Param        userId  path      string  true  "Appuser ID"
+// @Security     BearerAuth
+// @Success      200 {object} types.MemberPermissions
+// @Router       /api/v1/appservers/{id}/members/{userId}/permissions [get]
+func AppserverMemberPermissionsHandler(w http.ResponseWriter, r *http.Request) {
+	renderMemberPermissions(w, r, chi.URLParam(r, "id"), chi.URLParam(r, "userId"))
+}
+
+// AppserverMyPermissionsHandler godoc
+// @Summary      Get the caller's permissions
+// @Description  Resolve the caller's permissions in the appserver and each of its channels, with the roles granting them. Owners get every permission
+// @Tags         appserver
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "Appserver ID"
+// @Security     BearerAuth
+// @Success      200 {object} types.MemberPermissions
+// @Router       /api/v1/appservers/{id}/me/permissions [get]
+func AppserverMyPermissionsHandler(w http.ResponseWriter, r *http.Request) {
+	authT, _ := auth.GetAuthotizationToken(r)
+	renderMemberPermissions(w, r, chi.URLParam(r, "id"), authT.Claims.UserID)
+}
+
+func renderMemberPermissions(w http.ResponseWriter, r *http.Request, sId, userId string) {
+	req := &appserver.GetByIdRequest{
+		Id: sId,
+	}
+	if !validateRequest(w, r, req) {
+		return
+	}
+
+	authT, _ := auth.GetAuthotizationToken(r)
+	ctx, cancel := service.SetupGrpcHeaders(r.Context(), authT.Token, BackendTimeout(r))
+	defer cancel()
+
+	c := service.NewGrpcClient()
+	response, err := c.GetAppserverClient().GetById(ctx, req)
+
+	if err != nil {
+		HandleGrpcError(w, r, err)
+		return
+	}
+
+	// is_owner describes the caller, the owner of the server is not exposed
+	isOwner := response.Appserver.IsOwner && userId == authT.Claims.UserID
+
+	channelResponse, err := c.GetChannelClient().ListServerChannels(
+		ctx, &channel.ListServerChannelsRequest{
+			AppserverId: sId,
+		},
+	)
+
+	if err != nil {
+		HandleGrpcError(w, r, err)
+		return
+	}
+
+	var roles []*appserver_role.AppserverRole
+	if !isOwner {
+		roleSubsResponse, err := c.GetAppserverRoleSubClient().ListServerRoleSubs(
+			ctx, &appserver_role_sub.ListServerRoleSubsRequest{
+				AppserverId: sId,
+			},
+		)
+
+		if err != nil {
+			HandleGrpcError(w, r, err)
+			return
+		}
+
+		rolesResponse, err := c.GetAppserverRoleClient().ListServerRoles(
+			ctx, &appserver_role.ListServerRolesRequest{
+				AppserverId: sId,
+			},
+		)
+
+		if err != nil {
+			HandleGrpcError(w, r, err)
+			return
+		}
+
+		roles = permissions.UserRoles(sId, userId, roleSubsResponse.AppserverRoleSubs, rolesResponse.AppserverRoles)
+	}
+
+	channels := make([]types.ChannelPermissions, 0, len(channelResponse.Channels))
+
+	for _, ch := range channelResponse.Channels {
+		channelRoles := roles
+		if ch.IsPrivate && !isOwner {
+			channelRolesResponse, err := c.GetChannelRoleClient().ListChannelRoles(
+				ctx, &channel_role.ListChannelRolesRequest{
+					ChannelId:   ch.Id,
+					AppserverId: sId,
+				},
+			)
+
+			if err != nil {
+				HandleGrpcError(w, r, err)
+				return
+			}
+
+			channelRoles = permissions.ChannelRoles(ch, channelRolesResponse.ChannelRoles, roles)
+		}
+
+		channels = append(channels, types.ChannelPermissions{
+			ChannelId:           ch.Id,
+			IsPrivate:           ch.IsPrivate,
+			ResolvedPermissions: resolvePermissions(permissions.ChannelScope, isOwner, channelRoles),
+		})
+	}
+
+	render.JSON(w, r, CreateResponse(&types.MemberPermissions{
+		AppserverId: sId,
+		AppuserId:   userId,
+		IsOwner:     isOwner,
+		Appserver:   resolvePermissions(permissions.AppserverScope, isOwner, roles),
+		Sub:         resolvePermissions(permissions.SubScope, isOwner, roles),
+		Channels:    channels,
+	}))
+}
+
+func resolvePermissions(scope permissions.Scope, isOwner bool, roles []*appserver_role.AppserverRole) types.ResolvedPermissions {
+	mask := permissions.Combine(roles).Mask(scope)
+	if isOwner {
+		mask = permissions.AllMask(scope)
+	}
+
+	grants := permissions.Grants(scope, isOwner, roles)
+	res := types.ResolvedPermissions{Mask: mask, Grants: make([]types.PermissionGrant, 0, len(grants))}
+	for _, g := range grants {
+		res.Grants = append(res.Grants, types.PermissionGrant{
+			Permission: g.Permission.Name,
+			RoleIds:    g.RoleIDs,
+		})
+	}
+	return res
+}
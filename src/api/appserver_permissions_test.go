@@ -0,0 +1,177 @@
+package api_test
+
+import (
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"mistapi/src/api"
+	"mistapi/src/permissions"
+	"mistapi/src/problem"
+	"mistapi/src/protos/v1/appserver"
+	"mistapi/src/protos/v1/appserver_role"
+	"mistapi/src/protos/v1/appserver_role_sub"
+	"mistapi/src/protos/v1/channel"
+	"mistapi/src/protos/v1/channel_role"
+	"mistapi/src/testutil"
+	"mistapi/src/types"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAppserverMemberPermissions(t *testing.T) {
+	log.SetOutput(new(strings.Builder))
+
+	r := chi.NewRouter()
+	r.Get("/{id}/members/{userId}/permissions", api.AppserverMemberPermissionsHandler)
+	r.Get("/{id}/me/permissions", api.AppserverMyPermissionsHandler)
+
+	channels := &channel.ListServerChannelsResponse{Channels: []*channel.Channel{
+		{Id: "00000000-0000-0000-0000-000000000a05", AppserverId: "00000000-0000-0000-0000-000000000a04"},
+		{Id: "00000000-0000-0000-0000-000000000a06", AppserverId: "00000000-0000-0000-0000-000000000a04", IsPrivate: true},
+	}}
+
+	t.Run("Success:roles_are_resolved_per_channel", func(t *testing.T) {
+		// ARRANGE
+		mockAppserver := new(testutil.MockAppserverService)
+		mockAppserver.On("GetById", mock.Anything, &appserver.GetByIdRequest{Id: "00000000-0000-0000-0000-000000000a04"}).Return(
+			&appserver.GetByIdResponse{Appserver: &appserver.Appserver{Id: "00000000-0000-0000-0000-000000000a04", IsOwner: true}}, nil)
+
+		mockChannel := new(testutil.MockChannelService)
+		mockChannel.On("ListServerChannels", mock.Anything, &channel.ListServerChannelsRequest{AppserverId: "00000000-0000-0000-0000-000000000a04"}).
+			Return(channels, nil)
+
+		mockRoleSubs := new(testutil.MockAppserverRoleSubService)
+		mockRoleSubs.On("ListServerRoleSubs", mock.Anything, mock.Anything).Return(
+			&appserver_role_sub.ListServerRoleSubsResponse{AppserverRoleSubs: []*appserver_role_sub.AppserverRoleSub{
+				{AppuserId: "00000000-0000-0000-0000-000000000456", AppserverId: "00000000-0000-0000-0000-000000000a04", AppserverRoleId: "00000000-0000-0000-0000-000000000a07"},
+				{AppuserId: "00000000-0000-0000-0000-000000000456", AppserverId: "00000000-0000-0000-0000-000000000a04", AppserverRoleId: "00000000-0000-0000-0000-000000000a08"},
+			}}, nil)
+
+		mockRoles := new(testutil.MockAppserverRoleService)
+		mockRoles.On("ListServerRoles", mock.Anything, mock.Anything).Return(
+			&appserver_role.ListServerRolesResponse{AppserverRoles: []*appserver_role.AppserverRole{
+				{Id: "00000000-0000-0000-0000-000000000a07", AppserverId: "00000000-0000-0000-0000-000000000a04", AppserverPermissionMask: permissions.ManageChannels.Bit,
+					ChannelPermissionMask: permissions.ViewChannel.Bit},
+				{Id: "00000000-0000-0000-0000-000000000a08", AppserverId: "00000000-0000-0000-0000-000000000a04", SubPermissionMask: permissions.KickMembers.Bit,
+					ChannelPermissionMask: permissions.ViewChannel.Bit | permissions.ManageMessages.Bit},
+			}}, nil)
+
+		mockChannelRoles := new(testutil.MockChannelRoleService)
+		mockChannelRoles.On("ListChannelRoles", mock.Anything,
+			&channel_role.ListChannelRolesRequest{ChannelId: "00000000-0000-0000-0000-000000000a06", AppserverId: "00000000-0000-0000-0000-000000000a04"}).Return(
+			&channel_role.ListChannelRolesResponse{ChannelRoles: []*channel_role.ChannelRole{
+				{ChannelId: "00000000-0000-0000-0000-000000000a06", AppserverId: "00000000-0000-0000-0000-000000000a04", AppserverRoleId: "00000000-0000-0000-0000-000000000a08"},
+			}}, nil)
+
+		mockClient := new(testutil.MockClient)
+		mockClient.On("GetAppserverClient").Return(mockAppserver)
+		mockClient.On("GetChannelClient").Return(mockChannel)
+		mockClient.On("GetAppserverRoleSubClient").Return(mockRoleSubs)
+		mockClient.On("GetAppserverRoleClient").Return(mockRoles)
+		mockClient.On("GetChannelRoleClient").Return(mockChannelRoles)
+		testutil.MockGrpcClient(t, mockClient)
+
+		view := permissions.ViewChannel.Bit
+		manage := permissions.ManageMessages.Bit
+		expected := marshallResponse(t, api.CreateResponse(&types.MemberPermissions{
+			AppserverId: "00000000-0000-0000-0000-000000000a04",
+			AppuserId:   "00000000-0000-0000-0000-000000000456",
+			Appserver: types.ResolvedPermissions{Mask: permissions.ManageChannels.Bit, Grants: []types.PermissionGrant{
+				{Permission: "manage_channels", RoleIds: []string{"00000000-0000-0000-0000-000000000a07"}},
+			}},
+			Sub: types.ResolvedPermissions{Mask: permissions.KickMembers.Bit, Grants: []types.PermissionGrant{
+				{Permission: "kick_members", RoleIds: []string{"00000000-0000-0000-0000-000000000a08"}},
+			}},
+			Channels: []types.ChannelPermissions{
+				{ChannelId: "00000000-0000-0000-0000-000000000a05", ResolvedPermissions: types.ResolvedPermissions{Mask: view | manage,
+					Grants: []types.PermissionGrant{
+						{Permission: "view_channel", RoleIds: []string{"00000000-0000-0000-0000-000000000a07", "00000000-0000-0000-0000-000000000a08"}},
+						{Permission: "manage_messages", RoleIds: []string{"00000000-0000-0000-0000-000000000a08"}},
+					}}},
+				{ChannelId: "00000000-0000-0000-0000-000000000a06", IsPrivate: true, ResolvedPermissions: types.ResolvedPermissions{Mask: view | manage,
+					Grants: []types.PermissionGrant{
+						{Permission: "view_channel", RoleIds: []string{"00000000-0000-0000-0000-000000000a08"}},
+						{Permission: "manage_messages", RoleIds: []string{"00000000-0000-0000-0000-000000000a08"}},
+					}}},
+			},
+		}))
+
+		req, err := http.NewRequest(http.MethodGet, "/00000000-0000-0000-0000-000000000a04/members/00000000-0000-0000-0000-000000000456/permissions", nil)
+		require.NoError(t, err)
+		req = addContextHeaders(req)
+		rr := httptest.NewRecorder()
+
+		// ACT
+		r.ServeHTTP(rr, req)
+
+		// ASSERT
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, expected, rr.Body.String())
+	})
+
+	t.Run("Success:owner_has_every_permission", func(t *testing.T) {
+		// ARRANGE
+		mockAppserver := new(testutil.MockAppserverService)
+		mockAppserver.On("GetById", mock.Anything, &appserver.GetByIdRequest{Id: "00000000-0000-0000-0000-000000000a04"}).Return(
+			&appserver.GetByIdResponse{Appserver: &appserver.Appserver{Id: "00000000-0000-0000-0000-000000000a04", IsOwner: true}}, nil)
+
+		mockChannel := new(testutil.MockChannelService)
+		mockChannel.On("ListServerChannels", mock.Anything, mock.Anything).Return(channels, nil)
+
+		mockClient := new(testutil.MockClient)
+		mockClient.On("GetAppserverClient").Return(mockAppserver)
+		mockClient.On("GetChannelClient").Return(mockChannel)
+		testutil.MockGrpcClient(t, mockClient)
+
+		req, err := http.NewRequest(http.MethodGet, "/00000000-0000-0000-0000-000000000a04/me/permissions", nil)
+		require.NoError(t, err)
+		req = addContextHeaders(req)
+		rr := httptest.NewRecorder()
+
+		// ACT
+		r.ServeHTTP(rr, req)
+
+		// ASSERT
+		require.Equal(t, http.StatusOK, rr.Code)
+		body := rr.Body.String()
+		assert.Contains(t, body, `"appuser_id":"123","is_owner":true`)
+		assert.Contains(t, body, `{"channel_id":"00000000-0000-0000-0000-000000000a06","is_private":true,"mask":7,`)
+		for _, p := range permissions.All {
+			assert.Contains(t, body, `{"permission":"`+p.Name+`","role_ids":[]}`)
+		}
+		mockClient.AssertNotCalled(t, "GetAppserverRoleSubClient")
+		mockClient.AssertNotCalled(t, "GetChannelRoleClient")
+	})
+
+	t.Run("Error:missing_appserver_returns_not_found", func(t *testing.T) {
+		// ARRANGE
+		expected := marshallResponse(t, problem.New(http.StatusNotFound, problem.NotFound, "Not found."))
+		mockAppserver := new(testutil.MockAppserverService)
+		mockAppserver.On("GetById", mock.Anything, mock.Anything).Return(
+			nil, status.Error(codes.NotFound, "missing"))
+
+		mockClient := new(testutil.MockClient)
+		mockClient.On("GetAppserverClient").Return(mockAppserver)
+		testutil.MockGrpcClient(t, mockClient)
+
+		req, err := http.NewRequest(http.MethodGet, "/00000000-0000-0000-0000-000000000a04/me/permissions", nil)
+		require.NoError(t, err)
+		req = addContextHeaders(req)
+		rr := httptest.NewRecorder()
+
+		// ACT
+		r.ServeHTTP(rr, req)
+
+		// ASSERT
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+		assert.JSONEq(t, expected, rr.Body.String())
+	})
+}
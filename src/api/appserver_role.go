@@ -1,9 +1,11 @@
 package api
 
 import (
+	"fmt"
 	"net/http"
 
 	"mistapi/src/auth"
+	"mistapi/src/permissions"
 	"mistapi/src/protos/v1/appserver_role"
 	"mistapi/src/service"
 	"mistapi/src/types"
@@ -15,51 +17,135 @@ import (
 func appserverRoleRouter() http.Handler {
 	r := chi.NewRouter()
 
-	r.Post("/", AppserverRoleCreateHandler)       // create an appserver role
-	r.Delete("/{id}", AppserverRoleDeleteHandler) // delete an appserver role
+	r.With(decodeBody[types.AppserverRoleCreate],
+		requirePermissions(bodyAppserver(func(c types.AppserverRoleCreate) string { return c.AppserverId }), permissions.ManageRoles)).
+		Post("/", AppserverRoleCreateHandler) // create an appserver role
+	r.With(ValidatePathParams, decodePatch, requirePermissions(patchAppserver, permissions.ManageRoles)).
+		Patch("/{id}", AppserverRoleUpdateHandler) // update an appserver role
+	r.With(ValidatePathParams, requirePermissionsIfSet(permissions.QueryParam("appserver_id"), permissions.ManageRoles)).
+		Delete("/{id}", AppserverRoleDeleteHandler) // delete an appserver role
 	return r
 }
 
 // AppserverRoleCreateHandler godoc
 // @Summary      Create an appserver role
-// @Description  Create an appserver role
+// @Description  Create an appserver role. Each permission mask can be given as a raw integer,
+// @Description  as a list of permission names or both, in which case they are combined.
 // @Tags         appserver-roles
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
 // @Param        appserver  body      types.AppserverRoleCreate  true  "AppserverRoleCreate"
 // @Success      201 {object} types.AppserverRole
+// @Failure      400 {object} ErrorResponse "Unknown permission names or bits"
 // @Router       /api/v1/appserver-roles [post]
 func AppserverRoleCreateHandler(w http.ResponseWriter, r *http.Request) {
-	var role types.AppserverRoleCreate
-
-	err := DecodeRequestBody(w, r, &role)
+	role, err := requestBody[types.AppserverRoleCreate](w, r)
 	if err != nil {
 		return
 	}
 
+	masks, errs := rolePermissionMasks(role.RolePermissions)
+	if len(errs) > 0 {
+		RenderFieldErrors(w, r, "Invalid permissions.", errs)
+		return
+	}
+
+	req := &appserver_role.CreateRequest{
+		Name:                    role.Name,
+		AppserverId:             role.AppserverId,
+		AppserverPermissionMask: masks[permissions.AppserverScope],
+		ChannelPermissionMask:   masks[permissions.ChannelScope],
+		SubPermissionMask:       masks[permissions.SubScope],
+	}
+	if !validateRequest(w, r, req) {
+		return
+	}
+
 	authT, _ := auth.GetAuthotizationToken(r)
-	ctx, cancel := service.SetupGrpcHeaders(authT.Token)
+	ctx, cancel := service.SetupGrpcHeaders(r.Context(), authT.Token, BackendTimeout(r))
 	defer cancel()
 
 	c := service.NewGrpcClient()
-	response, err := c.GetAppserverRoleClient().Create(
-		ctx, &appserver_role.CreateRequest{
-			Name:        role.Name,
-			AppserverId: role.AppserverId,
-		},
-	)
+	response, err := c.GetAppserverRoleClient().Create(ctx, req)
 
 	if err != nil {
 		HandleGrpcError(w, r, err)
 		return
 	}
 	render.Status(r, http.StatusCreated)
-	render.JSON(w, r, CreateResponse(&types.AppserverRole{
-		ID:          response.AppserverRole.Id,
-		Name:        response.AppserverRole.Name,
-		AppserverId: response.AppserverRole.AppserverId,
-	}))
+	render.JSON(w, r, CreateResponse(appserverRoleFromProto(response.AppserverRole)))
+}
+
+// AppserverRoleUpdateHandler godoc
+// @Summary      Update an appserver role
+// @Description  Apply a JSON merge patch to a role, keeping its subscriptions and channel mappings.
+// @Description  appserver_id is required and cannot be changed. A permission list replaces the mask
+// @Description  of its scope. When updated_at is given the update only succeeds if the role has not
+// @Description  changed since, otherwise 412 is returned.
+// @Tags         appserver-roles
+// @Accept       json
+// @Produce      json
+// @Param        id     path      string                    true  "Appserver role ID"
+// @Param        patch  body      types.AppserverRolePatch  true  "AppserverRolePatch"
+// @Security     BearerAuth
+// @Success      200 {object} types.AppserverRole
+// @Failure      400 {object} ErrorResponse
+// @Failure      412 {object} ErrorResponse
+// @Router       /api/v1/appserver-roles/{id} [patch]
+func AppserverRoleUpdateHandler(w http.ResponseWriter, r *http.Request) {
+	rId := chi.URLParam(r, "id")
+
+	patch, ok := decodeMergePatch(w, r)
+	if !ok {
+		return
+	}
+
+	role := &appserver_role.AppserverRole{Id: rId}
+	patch.key("appserver_id", &role.AppserverId)
+	patch.name("name", &role.Name, "name")
+
+	var perms types.RolePermissions
+	patch.take("appserver_permission_mask", &perms.AppserverPermissionMask, "appserver_permission_mask", true)
+	patch.take("appserver_permissions", &perms.AppserverPermissions, "appserver_permission_mask", true)
+	patch.take("channel_permission_mask", &perms.ChannelPermissionMask, "channel_permission_mask", true)
+	patch.take("channel_permissions", &perms.ChannelPermissions, "channel_permission_mask", true)
+	patch.take("sub_permission_mask", &perms.SubPermissionMask, "sub_permission_mask", true)
+	patch.take("sub_permissions", &perms.SubPermissions, "sub_permission_mask", true)
+
+	masks, errs := rolePermissionMasks(perms)
+	patch.errs = append(patch.errs, errs...)
+	role.AppserverPermissionMask = masks[permissions.AppserverScope]
+	role.ChannelPermissionMask = masks[permissions.ChannelScope]
+	role.SubPermissionMask = masks[permissions.SubScope]
+
+	updatedAt := patch.updatedAt()
+	if !patch.done(w, r) {
+		return
+	}
+
+	req := &appserver_role.UpdateRequest{
+		AppserverRole:     role,
+		UpdateMask:        patch.mask(),
+		ExpectedUpdatedAt: updatedAt,
+	}
+	if !validateRequest(w, r, req) {
+		return
+	}
+
+	authT, _ := auth.GetAuthotizationToken(r)
+	ctx, cancel := service.SetupGrpcHeaders(r.Context(), authT.Token, BackendTimeout(r))
+	defer cancel()
+
+	c := service.NewGrpcClient()
+	response, err := c.GetAppserverRoleClient().Update(ctx, req)
+
+	if err != nil {
+		HandleGrpcError(w, r, err)
+		return
+	}
+
+	render.JSON(w, r, CreateResponse(appserverRoleFromProto(response.AppserverRole)))
 }
 
 // AppserverRoleDeleteHandler godoc
@@ -68,23 +154,30 @@ func AppserverRoleCreateHandler(w http.ResponseWriter, r *http.Request) {
 // @Tags         appserver-roles
 // @Accept       json
 // @Produce      json
-// @Param        id   path      string  true  "Appserver role ID"
+// @Param        id            path   string  true  "Appserver role ID"
+// @Param        appserver_id  query  string  false  "Appserver of the role, checks the caller's permissions in the gateway"
 // @Security     BearerAuth
 // @Success      204
+// @Failure      400 {object} ErrorResponse
+// @Failure      403 {object} ErrorResponse
 // @Router       /api/v1/appserver-roles/{id} [delete]
 func AppserverRoleDeleteHandler(w http.ResponseWriter, r *http.Request) {
 	sId := chi.URLParam(r, "id")
 
+	req := &appserver_role.DeleteRequest{
+		Id:          sId,
+		AppserverId: r.URL.Query().Get("appserver_id"),
+	}
+	if !validateOptionalAppserver(w, r, req, req.AppserverId) {
+		return
+	}
+
 	authT, _ := auth.GetAuthotizationToken(r)
-	ctx, cancel := service.SetupGrpcHeaders(authT.Token)
+	ctx, cancel := service.SetupGrpcHeaders(r.Context(), authT.Token, BackendTimeout(r))
 	defer cancel()
 
 	c := service.NewGrpcClient()
-	_, err := c.GetAppserverRoleClient().Delete(
-		ctx, &appserver_role.DeleteRequest{
-			Id: sId,
-		},
-	)
+	_, err := c.GetAppserverRoleClient().Delete(ctx, req)
 
 	if err != nil {
 		HandleGrpcError(w, r, err)
@@ -93,3 +186,60 @@ func AppserverRoleDeleteHandler(w http.ResponseWriter, r *http.Request) {
 
 	render.NoContent(w, r)
 }
+
+func appserverRoleFromProto(role *appserver_role.AppserverRole) types.AppserverRole {
+	return types.AppserverRole{
+		ID:          role.Id,
+		Name:        role.Name,
+		AppserverId: role.AppserverId,
+		UpdatedAt:   protoTime(role.UpdatedAt),
+		RolePermissions: types.RolePermissions{
+			AppserverPermissionMask: role.AppserverPermissionMask,
+			ChannelPermissionMask:   role.ChannelPermissionMask,
+			SubPermissionMask:       role.SubPermissionMask,
+			AppserverPermissions:    permissions.Names(permissions.AppserverScope, role.AppserverPermissionMask),
+			ChannelPermissions:      permissions.Names(permissions.ChannelScope, role.ChannelPermissionMask),
+			SubPermissions:          permissions.Names(permissions.SubScope, role.SubPermissionMask),
+		},
+	}
+}
+
+// rolePermissionMasks combines the raw masks and named permissions of p per
+// scope. Unknown names and bits are reported against the field they came from.
+func rolePermissionMasks(p types.RolePermissions) (map[permissions.Scope]int64, []FieldError) {
+	fields := []struct {
+		scope permissions.Scope
+		mask  int64
+		names []string
+	}{
+		{permissions.AppserverScope, p.AppserverPermissionMask, p.AppserverPermissions},
+		{permissions.ChannelScope, p.ChannelPermissionMask, p.ChannelPermissions},
+		{permissions.SubScope, p.SubPermissionMask, p.SubPermissions},
+	}
+
+	masks := map[permissions.Scope]int64{}
+	errs := []FieldError{}
+	for _, f := range fields {
+		if f.mask&^permissions.AllMask(f.scope) != 0 {
+			errs = append(errs, FieldError{
+				Field:   fmt.Sprintf("%s_permission_mask", f.scope),
+				Message: "contains unknown permission bits",
+			})
+		}
+		mask := f.mask
+
+		for i, name := range f.names {
+			perm, ok := permissions.Lookup(f.scope, name)
+			if !ok {
+				errs = append(errs, FieldError{
+					Field:   fmt.Sprintf("%s_permissions[%d]", f.scope, i),
+					Message: fmt.Sprintf("unknown %s permission %q", f.scope, name),
+				})
+				continue
+			}
+			mask |= perm.Bit
+		}
+		masks[f.scope] = mask
+	}
+	return masks, errs
+}
@@ -4,6 +4,8 @@ import (
 	"net/http"
 
 	"mistapi/src/auth"
+	"mistapi/src/events"
+	"mistapi/src/permissions"
 	"mistapi/src/protos/v1/appserver_role_sub"
 	"mistapi/src/service"
 	"mistapi/src/types"
@@ -15,8 +17,11 @@ import (
 func appserverRoleSubRouter() http.Handler {
 	r := chi.NewRouter()
 
-	r.Post("/", AppserverRoleSubCreateHandler)       // create a new role sub
-	r.Delete("/{id}", AppserverRoleSubDeleteHandler) // delete a role sub
+	r.With(decodeBody[types.AppserverRoleSubCreate],
+		requirePermissions(bodyAppserver(func(c types.AppserverRoleSubCreate) string { return c.AppserverId }), permissions.AssignRoles)).
+		Post("/", AppserverRoleSubCreateHandler) // create a new role sub
+	r.With(ValidatePathParams, requirePermissionsIfSet(permissions.QueryParam("appserver_id"), permissions.AssignRoles)).
+		Delete("/{id}", AppserverRoleSubDeleteHandler) // delete a role sub
 	return r
 }
 
@@ -31,62 +36,95 @@ func appserverRoleSubRouter() http.Handler {
 // @Success      204
 // @Router       /api/v1/appserver-role-subs [post]
 func AppserverRoleSubCreateHandler(w http.ResponseWriter, r *http.Request) {
-	var roleSub types.AppserverRoleSubCreate
-
-	err := DecodeRequestBody(w, r, &roleSub)
+	roleSub, err := requestBody[types.AppserverRoleSubCreate](w, r)
 	if err != nil {
 		return
 	}
 
+	req := &appserver_role_sub.CreateRequest{
+		AppuserId:       roleSub.AppuserId,
+		AppserverRoleId: roleSub.AppserverRoleId,
+		AppserverId:     roleSub.AppserverId,
+		AppserverSubId:  roleSub.AppserverSubId,
+	}
+	if !validateRequest(w, r, req) {
+		return
+	}
+
 	authT, _ := auth.GetAuthotizationToken(r)
-	ctx, cancel := service.SetupGrpcHeaders(authT.Token)
+	ctx, cancel := service.SetupGrpcHeaders(r.Context(), authT.Token, BackendTimeout(r))
 	defer cancel()
 
 	c := service.NewGrpcClient()
-	_, err = c.GetAppserverRoleSubClient().Create(
-		ctx, &appserver_role_sub.CreateRequest{
-			AppuserId:       roleSub.AppuserId,
-			AppserverRoleId: roleSub.AppserverRoleId,
-			AppserverId:     roleSub.AppserverId,
-			AppserverSubId:  roleSub.AppserverSubId,
-		},
-	)
+	response, err := c.GetAppserverRoleSubClient().Create(ctx, req)
 
 	if err != nil {
 		HandleGrpcError(w, r, err)
 		return
 	}
+
+	events.Publish(r.Context(), events.Event{
+		Type:        events.RoleAssigned,
+		AppserverID: roleSub.AppserverId,
+		AppuserID:   roleSub.AppuserId,
+		Data: &types.AppserverRoleSub{
+			ID:              response.GetAppserverRoleSub().GetId(),
+			AppuserId:       roleSub.AppuserId,
+			AppserverRoleId: roleSub.AppserverRoleId,
+			AppserverId:     roleSub.AppserverId,
+		},
+	})
+
 	render.NoContent(w, r)
 }
 
 // AppserverRoleSubDeleteHandler godoc
 // @Summary      Delete a user role subscription
-// @Description  Delete a role sub entry by ID
+// @Description  Delete a role sub entry by ID. The role.unassigned event is only published when appserver_id is set
 // @Tags         appserver-role-subs
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
-// @Param        id   path  string  true  "Role Sub ID"
+// @Param        id            path   string  true   "Role Sub ID"
+// @Param        appserver_id  query  string  false  "Appserver of the role sub, checks the caller's permissions in the gateway"
 // @Success      204
+// @Failure      400 {object} ErrorResponse
+// @Failure      403 {object} ErrorResponse
 // @Router       /api/v1/appserver-role-subs/{id} [delete]
 func AppserverRoleSubDeleteHandler(w http.ResponseWriter, r *http.Request) {
 	id := chi.URLParam(r, "id")
+	appserverId := r.URL.Query().Get("appserver_id")
+
+	req := &appserver_role_sub.DeleteRequest{
+		Id:          id,
+		AppserverId: appserverId,
+	}
+	if !validateOptionalAppserver(w, r, req, appserverId) {
+		return
+	}
 
 	authT, _ := auth.GetAuthotizationToken(r)
-	ctx, cancel := service.SetupGrpcHeaders(authT.Token)
+	ctx, cancel := service.SetupGrpcHeaders(r.Context(), authT.Token, BackendTimeout(r))
 	defer cancel()
 
 	c := service.NewGrpcClient()
-	_, err := c.GetAppserverRoleSubClient().Delete(
-		ctx, &appserver_role_sub.DeleteRequest{
-			Id: id,
-		},
-	)
+	_, err := c.GetAppserverRoleSubClient().Delete(ctx, req)
 
 	if err != nil {
 		HandleGrpcError(w, r, err)
 		return
 	}
 
+	// the member losing the role is unknown, the broker then reloads the
+	// views of every subscriber of the appserver. Without appserver_id those
+	// are unknown too.
+	if appserverId != "" {
+		events.Publish(r.Context(), events.Event{
+			Type:        events.RoleUnassigned,
+			AppserverID: appserverId,
+			Data:        &types.AppserverRoleSub{ID: id, AppserverId: appserverId},
+		})
+	}
+
 	render.NoContent(w, r)
 }
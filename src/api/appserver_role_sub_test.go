@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"mistapi/src/api"
+	"mistapi/src/problem"
 	"mistapi/src/protos/v1/appserver_role_sub"
 	"mistapi/src/testutil"
 	"mistapi/src/types"
@@ -28,10 +29,10 @@ func TestCreateAppserverRoleSub(t *testing.T) {
 	t.Run("Success:creates_role_sub", func(t *testing.T) {
 		// ARRANGE
 		input := types.AppserverRoleSubCreate{
-			AppuserId:       "user",
-			AppserverRoleId: "role",
-			AppserverId:     "srv",
-			AppserverSubId:  "sub",
+			AppuserId:       "00000000-0000-0000-0000-000000000a09",
+			AppserverRoleId: "00000000-0000-0000-0000-000000000a0a",
+			AppserverId:     "00000000-0000-0000-0000-000000000a0c",
+			AppserverSubId:  "00000000-0000-0000-0000-000000000a0d",
 		}
 		mockReq := &appserver_role_sub.CreateRequest{
 			AppuserId:       input.AppuserId,
@@ -51,6 +52,7 @@ func TestCreateAppserverRoleSub(t *testing.T) {
 		payload := marshallPayload(t, input)
 		req, err := http.NewRequest("POST", roleSubUrl, payload)
 		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
 		req = addContextHeaders(req)
 		rr := httptest.NewRecorder()
 
@@ -63,11 +65,12 @@ func TestCreateAppserverRoleSub(t *testing.T) {
 
 	t.Run("Error:invalid_payload", func(t *testing.T) {
 		// ARRANGE
-		expected := marshallResponse(t, api.CreateErrorResponse("Invalid attributes provided."))
+		expected := marshallResponse(t, problem.New(http.StatusUnprocessableEntity, problem.InvalidBody, "Invalid attributes provided."))
 
 		payload := marshallPayload(t, "invalid")
 		req, err := http.NewRequest("POST", roleSubUrl, payload)
 		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
 		req = addContextHeaders(req)
 		rr := httptest.NewRecorder()
 
@@ -82,10 +85,10 @@ func TestCreateAppserverRoleSub(t *testing.T) {
 	t.Run("Error:grpc_error", func(t *testing.T) {
 		// ARRANGE
 		input := types.AppserverRoleSubCreate{
-			AppuserId:       "u",
-			AppserverRoleId: "r",
-			AppserverId:     "s",
-			AppserverSubId:  "x",
+			AppuserId:       "00000000-0000-0000-0000-000000000a0e",
+			AppserverRoleId: "00000000-0000-0000-0000-000000000a0f",
+			AppserverId:     "00000000-0000-0000-0000-000000000a10",
+			AppserverSubId:  "00000000-0000-0000-0000-000000000a11",
 		}
 		mockReq := &appserver_role_sub.CreateRequest{
 			AppuserId:       input.AppuserId,
@@ -103,6 +106,7 @@ func TestCreateAppserverRoleSub(t *testing.T) {
 		payload := marshallPayload(t, input)
 		req, err := http.NewRequest("POST", roleSubUrl, payload)
 		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
 		req = addContextHeaders(req)
 		rr := httptest.NewRecorder()
 
@@ -117,13 +121,14 @@ func TestCreateAppserverRoleSub(t *testing.T) {
 func TestDeleteAppserverRoleSub(t *testing.T) {
 	log.SetOutput(new(strings.Builder))
 
+	appserverId := "00000000-0000-0000-0000-000000000002"
 	r := chi.NewRouter()
 	r.Delete("/{id}", api.AppserverRoleSubDeleteHandler)
 
 	t.Run("Success:deletes_role_sub", func(t *testing.T) {
 		// ARRANGE
-		id := "1"
-		mockReq := &appserver_role_sub.DeleteRequest{Id: id}
+		id := "00000000-0000-0000-0000-000000000001"
+		mockReq := &appserver_role_sub.DeleteRequest{Id: id, AppserverId: appserverId}
 		mockResp := &appserver_role_sub.DeleteResponse{}
 
 		mockService := new(testutil.MockAppserverRoleSubService)
@@ -133,7 +138,7 @@ func TestDeleteAppserverRoleSub(t *testing.T) {
 		mockClient.On("GetAppserverRoleSubClient").Return(mockService)
 		testutil.MockGrpcClient(t, mockClient)
 
-		req, err := http.NewRequest("DELETE", fmt.Sprintf("/%s", id), nil)
+		req, err := http.NewRequest("DELETE", fmt.Sprintf("/%s?appserver_id=%s", id, appserverId), nil)
 		require.NoError(t, err)
 		req = addContextHeaders(req)
 		req = withURLParam(req, "id", id)
@@ -148,16 +153,16 @@ func TestDeleteAppserverRoleSub(t *testing.T) {
 
 	t.Run("Error:grpc_failure", func(t *testing.T) {
 		// ARRANGE
-		id := "fail"
+		id := "00000000-0000-0000-0000-000000000a12"
 		mockService := new(testutil.MockAppserverRoleSubService)
-		mockService.On("Delete", mock.Anything, &appserver_role_sub.DeleteRequest{Id: id}).
+		mockService.On("Delete", mock.Anything, &appserver_role_sub.DeleteRequest{Id: id, AppserverId: appserverId}).
 			Return(nil, errors.New("boom"))
 
 		mockClient := new(testutil.MockClient)
 		mockClient.On("GetAppserverRoleSubClient").Return(mockService)
 		testutil.MockGrpcClient(t, mockClient)
 
-		req, err := http.NewRequest("DELETE", fmt.Sprintf("/%s", id), nil)
+		req, err := http.NewRequest("DELETE", fmt.Sprintf("/%s?appserver_id=%s", id, appserverId), nil)
 		require.NoError(t, err)
 		req = addContextHeaders(req)
 		req = withURLParam(req, "id", id)
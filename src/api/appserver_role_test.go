@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"mistapi/src/api"
+	"mistapi/src/problem"
 	"mistapi/src/protos/v1/appserver_role"
 	"mistapi/src/types"
 
@@ -19,6 +20,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 func TestCreateAppserverRole(t *testing.T) {
@@ -29,9 +31,12 @@ func TestCreateAppserverRole(t *testing.T) {
 	t.Run("Success:successfully_creating_appserver_role", func(t *testing.T) {
 		// ARRANGE
 		role := types.AppserverRole{
-			ID:          "1",
+			ID:          "00000000-0000-0000-0000-000000000001",
 			Name:        "foo",
-			AppserverId: "1",
+			AppserverId: "00000000-0000-0000-0000-000000000001",
+			RolePermissions: types.RolePermissions{
+				AppserverPermissions: []string{}, ChannelPermissions: []string{}, SubPermissions: []string{},
+			},
 		}
 		expected := marshallResponse(t, api.CreateResponse(role))
 		mockCreateRequest := &appserver_role.CreateRequest{Name: role.Name, AppserverId: role.AppserverId}
@@ -51,6 +56,7 @@ func TestCreateAppserverRole(t *testing.T) {
 		payload := marshallPayload(t, types.AppserverRoleCreate{Name: role.Name, AppserverId: role.AppserverId})
 		req, err := http.NewRequest("POST", url, payload)
 		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
 		req = addContextHeaders(req)
 		rr := httptest.NewRecorder()
 
@@ -62,11 +68,98 @@ func TestCreateAppserverRole(t *testing.T) {
 		assert.JSONEq(t, expected, rr.Body.String())
 	})
 
+	t.Run("Success:raw_masks_and_permission_names_are_combined", func(t *testing.T) {
+		// ARRANGE
+		mockCreateRequest := &appserver_role.CreateRequest{
+			Name:                    "mod",
+			AppserverId:             "00000000-0000-0000-0000-000000000001",
+			AppserverPermissionMask: 0,
+			ChannelPermissionMask:   5,
+			SubPermissionMask:       3,
+		}
+		mockCreateResponse := &appserver_role.CreateResponse{AppserverRole: &appserver_role.AppserverRole{
+			Id:                    "00000000-0000-0000-0000-000000000002",
+			Name:                  "mod",
+			AppserverId:           "00000000-0000-0000-0000-000000000001",
+			ChannelPermissionMask: 5,
+			SubPermissionMask:     3,
+		}}
+		expected := marshallResponse(t, api.CreateResponse(types.AppserverRole{
+			ID:          "00000000-0000-0000-0000-000000000002",
+			Name:        "mod",
+			AppserverId: "00000000-0000-0000-0000-000000000001",
+			RolePermissions: types.RolePermissions{
+				ChannelPermissionMask: 5,
+				SubPermissionMask:     3,
+				AppserverPermissions:  []string{},
+				ChannelPermissions:    []string{"view_channel", "manage_messages"},
+				SubPermissions:        []string{"kick_members", "ban_members"},
+			},
+		}))
+		mockService := new(testutil.MockAppserverRoleService)
+		mockService.On("Create", mock.Anything, mockCreateRequest).Return(mockCreateResponse, nil)
+
+		mockClient := new(testutil.MockClient)
+		mockClient.On("GetAppserverRoleClient").Return(mockService)
+		testutil.MockGrpcClient(t, mockClient)
+
+		// Prepare the HTTP request
+		payload := marshallPayload(t, types.AppserverRoleCreate{Name: "mod", AppserverId: "00000000-0000-0000-0000-000000000001",
+			RolePermissions: types.RolePermissions{
+				ChannelPermissionMask: 1,
+				ChannelPermissions:    []string{"manage_messages"},
+				SubPermissions:        []string{"kick_members", "ban_members"},
+			}})
+		req, err := http.NewRequest("POST", url, payload)
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req = addContextHeaders(req)
+		rr := httptest.NewRecorder()
+
+		// ACT
+		api.AppserverRoleCreateHandler(rr, req)
+
+		//  ASSERT
+		assert.Equal(t, http.StatusCreated, rr.Code)
+		assert.JSONEq(t, expected, rr.Body.String())
+	})
+
+	t.Run("Error:unknown_permissions_return_field_errors", func(t *testing.T) {
+		// ARRANGE
+		expected := problem.New(http.StatusBadRequest, problem.ValidationFailed, "Invalid permissions.")
+		expected.Errors = []api.FieldError{
+			{Field: "appserver_permissions[1]", Message: `unknown appserver permission "view_channel"`},
+			{Field: "sub_permission_mask", Message: "contains unknown permission bits"},
+		}
+		mockClient := new(testutil.MockClient)
+		testutil.MockGrpcClient(t, mockClient)
+
+		// Prepare the HTTP request
+		payload := marshallPayload(t, types.AppserverRoleCreate{Name: "foo", AppserverId: "00000000-0000-0000-0000-000000000001",
+			RolePermissions: types.RolePermissions{
+				AppserverPermissions: []string{"manage_roles", "view_channel"},
+				SubPermissionMask:    1 << 8,
+			}})
+		req, err := http.NewRequest("POST", url, payload)
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req = addContextHeaders(req)
+		rr := httptest.NewRecorder()
+
+		// ACT
+		api.AppserverRoleCreateHandler(rr, req)
+
+		//  ASSERT
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.JSONEq(t, marshallResponse(t, expected), rr.Body.String())
+		mockClient.AssertNotCalled(t, "GetAppserverRoleClient")
+	})
+
 	t.Run("Error:errors_during_creation_returns_error_status", func(t *testing.T) {
 		// ARRANGE
-		expected := marshallResponse(t, api.CreateErrorResponse("Internal Server Error."))
+		expected := marshallResponse(t, problem.New(http.StatusInternalServerError, problem.Internal, "Internal Server Error."))
 		mockService := new(testutil.MockAppserverRoleService)
-		mockCreateRequest := &appserver_role.CreateRequest{Name: "foo", AppserverId: "1"}
+		mockCreateRequest := &appserver_role.CreateRequest{Name: "foo", AppserverId: "00000000-0000-0000-0000-000000000001"}
 		mockResponse := &appserver_role.CreateResponse{}
 		mockService.On("Create", mock.Anything, mockCreateRequest).Return(mockResponse, errors.New("boom"))
 
@@ -75,9 +168,10 @@ func TestCreateAppserverRole(t *testing.T) {
 		testutil.MockGrpcClient(t, mockClient)
 
 		// Prepare the HTTP request
-		payload := marshallPayload(t, types.AppserverRoleCreate{Name: "foo", AppserverId: "1"})
+		payload := marshallPayload(t, types.AppserverRoleCreate{Name: "foo", AppserverId: "00000000-0000-0000-0000-000000000001"})
 		req, err := http.NewRequest("POST", url, payload)
 		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
 		req = addContextHeaders(req)
 		rr := httptest.NewRecorder()
 
@@ -91,9 +185,9 @@ func TestCreateAppserverRole(t *testing.T) {
 
 	t.Run("Error:errors_with_invalid_post_parameters", func(t *testing.T) {
 		// ARRANGE
-		expected := marshallResponse(t, api.CreateErrorResponse("Invalid attributes provided."))
+		expected := marshallResponse(t, problem.New(http.StatusUnprocessableEntity, problem.InvalidBody, "Invalid attributes provided."))
 		mockService := new(testutil.MockAppserverRoleService)
-		mockCreateRequest := &appserver_role.CreateRequest{Name: "foo", AppserverId: "1"}
+		mockCreateRequest := &appserver_role.CreateRequest{Name: "foo", AppserverId: "00000000-0000-0000-0000-000000000001"}
 		mockResponse := &appserver_role.CreateResponse{}
 		mockService.On("Create", mock.Anything, mockCreateRequest).Return(mockResponse, nil)
 
@@ -105,6 +199,7 @@ func TestCreateAppserverRole(t *testing.T) {
 		payload := marshallPayload(t, "invalid")
 		req, err := http.NewRequest("POST", url, payload)
 		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
 		req = addContextHeaders(req)
 		rr := httptest.NewRecorder()
 
@@ -120,6 +215,7 @@ func TestCreateAppserverRole(t *testing.T) {
 func TestDeleteAppserverRole(t *testing.T) {
 	log.SetOutput(new(strings.Builder))
 
+	appserverId := "00000000-0000-0000-0000-000000000002"
 	r := chi.NewRouter()
 	r.Delete("/{id}", api.AppserverRoleDeleteHandler)
 	ts := httptest.NewServer(r)
@@ -127,8 +223,8 @@ func TestDeleteAppserverRole(t *testing.T) {
 
 	t.Run("Success:is_successful", func(t *testing.T) {
 		// ARRANGE
-		aId := "1"
-		mockDeleteRequest := &appserver_role.DeleteRequest{Id: aId}
+		aId := "00000000-0000-0000-0000-000000000001"
+		mockDeleteRequest := &appserver_role.DeleteRequest{Id: aId, AppserverId: appserverId}
 		mockDeleteResponse := &appserver_role.DeleteResponse{}
 
 		mockService := new(testutil.MockAppserverRoleService)
@@ -141,7 +237,7 @@ func TestDeleteAppserverRole(t *testing.T) {
 		testutil.MockGrpcClient(t, mockClient)
 
 		// Prepare the HTTP request
-		req, err := http.NewRequest("DELETE", fmt.Sprintf("/%s", aId), nil)
+		req, err := http.NewRequest("DELETE", fmt.Sprintf("/%s?appserver_id=%s", aId, appserverId), nil)
 		require.NoError(t, err)
 		rr := httptest.NewRecorder()
 		req = addContextHeaders(req)
@@ -156,9 +252,9 @@ func TestDeleteAppserverRole(t *testing.T) {
 
 	t.Run("Error:on_error_when_deleting_returns_error", func(t *testing.T) {
 		// ARRANGE
-		aId := "1"
+		aId := "00000000-0000-0000-0000-000000000001"
 		mockService := new(testutil.MockAppserverRoleService)
-		mockDeleteRequest := &appserver_role.DeleteRequest{Id: aId}
+		mockDeleteRequest := &appserver_role.DeleteRequest{Id: aId, AppserverId: appserverId}
 		mockResponse := &appserver_role.DeleteResponse{}
 		mockService.On("Delete", mock.Anything, mockDeleteRequest).Return(mockResponse, errors.New("boom"))
 		mockClient := new(testutil.MockClient)
@@ -166,7 +262,7 @@ func TestDeleteAppserverRole(t *testing.T) {
 		testutil.MockGrpcClient(t, mockClient)
 
 		// Prepare the HTTP request
-		req, err := http.NewRequest("DELETE", fmt.Sprintf("/%s", aId), nil)
+		req, err := http.NewRequest("DELETE", fmt.Sprintf("/%s?appserver_id=%s", aId, appserverId), nil)
 		require.NoError(t, err)
 		rr := httptest.NewRecorder()
 		req = addContextHeaders(req)
@@ -179,3 +275,115 @@ func TestDeleteAppserverRole(t *testing.T) {
 		assert.Equal(t, http.StatusInternalServerError, rr.Code)
 	})
 }
+
+func TestUpdateAppserverRole(t *testing.T) {
+	log.SetOutput(new(strings.Builder))
+
+	r := chi.NewRouter()
+	r.Patch("/{id}", api.AppserverRoleUpdateHandler)
+
+	t.Run("Success:permission_list_replaces_the_scope_mask", func(t *testing.T) {
+		// ARRANGE
+		mockRequest := &appserver_role.UpdateRequest{
+			AppserverRole: &appserver_role.AppserverRole{
+				Id: "00000000-0000-0000-0000-000000000001", AppserverId: "00000000-0000-0000-0000-000000000002", Name: "mod", ChannelPermissionMask: 6,
+			},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name", "channel_permission_mask"}},
+		}
+		mockResponse := &appserver_role.UpdateResponse{AppserverRole: &appserver_role.AppserverRole{
+			Id: "00000000-0000-0000-0000-000000000001", AppserverId: "00000000-0000-0000-0000-000000000002", Name: "mod", ChannelPermissionMask: 6, SubPermissionMask: 1,
+		}}
+		expected := marshallResponse(t, api.CreateResponse(types.AppserverRole{
+			ID: "00000000-0000-0000-0000-000000000001", AppserverId: "00000000-0000-0000-0000-000000000002", Name: "mod",
+			RolePermissions: types.RolePermissions{
+				ChannelPermissionMask: 6,
+				SubPermissionMask:     1,
+				AppserverPermissions:  []string{},
+				ChannelPermissions:    []string{"send_messages", "manage_messages"},
+				SubPermissions:        []string{"kick_members"},
+			},
+		}))
+		mockService := new(testutil.MockAppserverRoleService)
+		mockService.On("Update", mock.Anything, mockRequest).Return(mockResponse, nil)
+
+		mockClient := new(testutil.MockClient)
+		mockClient.On("GetAppserverRoleClient").Return(mockService)
+		testutil.MockGrpcClient(t, mockClient)
+
+		req := mergePatchRequest(t, "/00000000-0000-0000-0000-000000000001",
+			`{"appserver_id":"00000000-0000-0000-0000-000000000002","name":"mod","channel_permissions":["send_messages","manage_messages"]}`)
+		rr := httptest.NewRecorder()
+
+		// ACT
+		r.ServeHTTP(rr, req)
+
+		// ASSERT
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, expected, rr.Body.String())
+	})
+
+	t.Run("Error:unknown_permission_is_reported", func(t *testing.T) {
+		// ARRANGE
+		expected := problem.New(http.StatusBadRequest, problem.ValidationFailed, "Invalid patch.")
+		expected.Errors = []api.FieldError{
+			{Field: "sub_permissions[0]", Message: `unknown sub permission "fly"`},
+		}
+		mockClient := new(testutil.MockClient)
+		testutil.MockGrpcClient(t, mockClient)
+
+		req := mergePatchRequest(t, "/00000000-0000-0000-0000-000000000001", `{"appserver_id":"00000000-0000-0000-0000-000000000002","sub_permissions":["fly"]}`)
+		rr := httptest.NewRecorder()
+
+		// ACT
+		r.ServeHTTP(rr, req)
+
+		// ASSERT
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.JSONEq(t, marshallResponse(t, expected), rr.Body.String())
+	})
+
+	t.Run("Error:patch_without_changes_is_rejected", func(t *testing.T) {
+		// ARRANGE
+		expected := marshallResponse(t, problem.New(http.StatusBadRequest, problem.BadRequest, "No fields to update."))
+
+		req := mergePatchRequest(t, "/00000000-0000-0000-0000-000000000001", `{"appserver_id":"00000000-0000-0000-0000-000000000002"}`)
+		rr := httptest.NewRecorder()
+
+		// ACT
+		r.ServeHTTP(rr, req)
+
+		// ASSERT
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.JSONEq(t, expected, rr.Body.String())
+	})
+
+	names := []struct {
+		name    string
+		value   string
+		message string
+	}{
+		{"Error:empty_name_is_rejected", "", "value length must be at least 1 characters"},
+		{"Error:name_over_64_characters_is_rejected", strings.Repeat("a", 65), "value length must be at most 64 characters"},
+	}
+
+	for _, tt := range names {
+		t.Run(tt.name, func(t *testing.T) {
+			// ARRANGE
+			expected := problem.New(http.StatusBadRequest, problem.ValidationFailed, "Invalid patch.")
+			expected.Errors = []api.FieldError{{Field: "name", Message: tt.message}}
+			mockClient := new(testutil.MockClient)
+			testutil.MockGrpcClient(t, mockClient)
+
+			req := mergePatchRequest(t, "/00000000-0000-0000-0000-000000000001", `{"appserver_id":"00000000-0000-0000-0000-000000000002","name":"`+tt.value+`"}`)
+			rr := httptest.NewRecorder()
+
+			// ACT
+			r.ServeHTTP(rr, req)
+
+			// ASSERT
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+			assert.JSONEq(t, marshallResponse(t, expected), rr.Body.String())
+			mockClient.AssertNotCalled(t, "GetAppserverRoleClient")
+		})
+	}
+}
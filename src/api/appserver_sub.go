@@ -1,9 +1,13 @@
 package api
 
 import (
+	"context"
 	"net/http"
 
 	"mistapi/src/auth"
+	"mistapi/src/events"
+	"mistapi/src/logging"
+	"mistapi/src/permissions"
 	"mistapi/src/protos/v1/appserver_sub"
 	"mistapi/src/service"
 	"mistapi/src/types"
@@ -12,11 +16,54 @@ import (
 	"github.com/go-chi/render"
 )
 
+type ownSubKey struct{}
+
+// allowOwnSub lets callers delete their own sub, leaving the appserver,
+// without the permissions guard requires for the subs of other members. The
+// appserver of an own sub is passed on to the handler, so clients need not
+// send appserver_id to leave.
+func allowOwnSub(guard func(http.Handler) http.Handler) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		guarded := guard(next)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			appserverID, own := findOwnSub(r, chi.URLParam(r, "id"))
+			if !own {
+				guarded.ServeHTTP(w, r)
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), ownSubKey{}, appserverID)))
+		})
+	}
+}
+
+// findOwnSub returns the appserver of sub id when it belongs to the caller.
+// Lookup failures are logged and treated as another member's sub.
+func findOwnSub(r *http.Request, id string) (string, bool) {
+	authT, _ := auth.GetAuthotizationToken(r)
+	ctx, cancel := service.SetupGrpcHeaders(r.Context(), authT.Token, BackendTimeout(r))
+	defer cancel()
+
+	c := service.NewGrpcClient()
+	subs, err := c.GetAppserverSubClient().ListUserServerSubs(ctx, &appserver_sub.ListUserServerSubsRequest{})
+	if err != nil {
+		logging.FromContext(r.Context()).Info("Error while looking up the caller's subs", "error", err)
+		return "", false
+	}
+
+	for _, s := range subs.Appservers {
+		if s.SubId == id {
+			return s.Appserver.GetId(), true
+		}
+	}
+	return "", false
+}
+
 func appserverSubRouter() http.Handler {
 	r := chi.NewRouter()
 
-	r.Post("/", AppserverSubCreateHandler)       // create an appserver sub
-	r.Delete("/{id}", AppserverSubDeleteHandler) // delete an appserver sub
+	r.Post("/", AppserverSubCreateHandler) // create an appserver sub
+	r.With(ValidatePathParams, allowOwnSub(requirePermissionsIfSet(permissions.QueryParam("appserver_id"), permissions.KickMembers))).
+		Delete("/{id}", AppserverSubDeleteHandler) // delete an appserver sub
 	return r
 }
 
@@ -31,63 +78,104 @@ func appserverSubRouter() http.Handler {
 // @Success      201 {object} types.AppserverSub
 // @Router       /api/v1/appserver-subs [post]
 func AppserverSubCreateHandler(w http.ResponseWriter, r *http.Request) {
-	var sub types.AppserverSubCreate
-
-	err := DecodeRequestBody(w, r, &sub)
+	sub, err := DecodeRequestBody[types.AppserverSubCreate](w, r)
 	if err != nil {
 		return
 	}
 
+	req := &appserver_sub.CreateRequest{
+		AppserverId: sub.AppserverId,
+	}
+	if !validateRequest(w, r, req) {
+		return
+	}
+
 	authT, _ := auth.GetAuthotizationToken(r)
-	ctx, cancel := service.SetupGrpcHeaders(authT.Token)
+	ctx, cancel := service.SetupGrpcHeaders(r.Context(), authT.Token, BackendTimeout(r))
 	defer cancel()
 
 	c := service.NewGrpcClient()
-	response, err := c.GetAppserverSubClient().Create(
-		ctx, &appserver_sub.CreateRequest{
-			AppserverId: sub.AppserverId,
-		},
-	)
+	response, err := c.GetAppserverSubClient().Create(ctx, req)
 
 	if err != nil {
 		HandleGrpcError(w, r, err)
 		return
 	}
-	render.Status(r, http.StatusCreated)
-	render.JSON(w, r, CreateResponse(&types.AppserverSub{
+	created := &types.AppserverSub{
 		ID:          response.AppserverSub.Id,
 		AppserverId: response.AppserverSub.AppserverId,
-	}))
+	}
+	events.Publish(r.Context(), events.Event{
+		Type:        events.MemberJoined,
+		AppserverID: created.AppserverId,
+		Data: &types.AppserverSub{
+			ID:          created.ID,
+			AppuserId:   authT.Claims.UserID,
+			AppserverId: created.AppserverId,
+		},
+	})
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, CreateResponse(created))
 }
 
 // AppserverSubDeleteHandler godoc
 // @Summary      Delete appserver sub by id
-// @Description  Delete appserver sub by id (removing a user from channel)
+// @Description  Delete appserver sub by id (removing a user from channel). Members may always delete their own sub to
+// @Description  leave the appserver, other subs need kick_members. The member.left event of another member's sub is
+// @Description  only published when appserver_id is set
 // @Tags         appserver-subs
 // @Accept       json
 // @Produce      json
-// @Param        id   path      string  true  "Appserver sub ID"
+// @Param        id            path   string  true   "Appserver sub ID"
+// @Param        appserver_id  query  string  false  "Appserver of the sub, checks the caller's permissions in the gateway"
 // @Security     BearerAuth
 // @Success      204
+// @Failure      400 {object} ErrorResponse
+// @Failure      403 {object} ErrorResponse
 // @Router       /api/v1/appserver-subs/{id} [delete]
 func AppserverSubDeleteHandler(w http.ResponseWriter, r *http.Request) {
 	sId := chi.URLParam(r, "id")
-
+	appserverId := r.URL.Query().Get("appserver_id")
 	authT, _ := auth.GetAuthotizationToken(r)
-	ctx, cancel := service.SetupGrpcHeaders(authT.Token)
+
+	deleted := &types.AppserverSub{ID: sId}
+	if ownAppserver, own := r.Context().Value(ownSubKey{}).(string); own {
+		appserverId = ownAppserver
+		deleted.AppuserId = authT.Claims.UserID
+	}
+	deleted.AppserverId = appserverId
+
+	req := &appserver_sub.DeleteRequest{
+		Id:          sId,
+		AppserverId: appserverId,
+	}
+	if !validateOptionalAppserver(w, r, req, appserverId) {
+		return
+	}
+
+	ctx, cancel := service.SetupGrpcHeaders(r.Context(), authT.Token, BackendTimeout(r))
 	defer cancel()
 
 	c := service.NewGrpcClient()
-	_, err := c.GetAppserverSubClient().Delete(
-		ctx, &appserver_sub.DeleteRequest{
-			Id: sId,
-		},
-	)
+	_, err := c.GetAppserverSubClient().Delete(ctx, req)
 
 	if err != nil {
 		HandleGrpcError(w, r, err)
 		return
 	}
 
+	// the member kicked is unknown, the broker then reloads the views of
+	// every subscriber of the appserver. Without appserver_id those are
+	// unknown too.
+	if appserverId != "" {
+		events.Publish(r.Context(), events.Event{
+			Type:        events.MemberLeft,
+			AppserverID: appserverId,
+			Data:        deleted,
+			AppuserID:   deleted.AppuserId,
+		})
+	}
+
 	render.NoContent(w, r)
 }
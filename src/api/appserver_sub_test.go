@@ -10,6 +10,8 @@ import (
 	"testing"
 
 	"mistapi/src/api"
+	"mistapi/src/events"
+	"mistapi/src/problem"
 	"mistapi/src/protos/v1/appserver_sub"
 	"mistapi/src/testutil"
 	"mistapi/src/types"
@@ -28,8 +30,8 @@ func TestCreateAppserverSub(t *testing.T) {
 	t.Run("Success:successfully_creating_appserver_sub", func(t *testing.T) {
 		// ARRANGE
 		sub := types.AppserverSub{
-			ID:          "1",
-			AppserverId: "1",
+			ID:          "00000000-0000-0000-0000-000000000001",
+			AppserverId: "00000000-0000-0000-0000-000000000001",
 			AppuserId:   "",
 		}
 		expected := marshallResponse(t, api.CreateResponse(sub))
@@ -49,6 +51,7 @@ func TestCreateAppserverSub(t *testing.T) {
 		payload := marshallPayload(t, types.AppserverSubCreate{AppserverId: sub.AppserverId})
 		req, err := http.NewRequest("POST", url, payload)
 		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
 		req = addContextHeaders(req)
 		rr := httptest.NewRecorder()
 
@@ -62,9 +65,9 @@ func TestCreateAppserverSub(t *testing.T) {
 
 	t.Run("Error:errors_during_creation_returns_error_status", func(t *testing.T) {
 		// ARRANGE
-		expected := marshallResponse(t, api.CreateErrorResponse("Internal Server Error."))
+		expected := marshallResponse(t, problem.New(http.StatusInternalServerError, problem.Internal, "Internal Server Error."))
 		mockService := new(testutil.MockAppserverSubService)
-		mockCreateRequest := &appserver_sub.CreateRequest{AppserverId: "1"}
+		mockCreateRequest := &appserver_sub.CreateRequest{AppserverId: "00000000-0000-0000-0000-000000000001"}
 		mockResponse := &appserver_sub.CreateResponse{}
 		mockService.On("Create", mock.Anything, mockCreateRequest).Return(mockResponse, errors.New("boom"))
 
@@ -73,9 +76,10 @@ func TestCreateAppserverSub(t *testing.T) {
 		testutil.MockGrpcClient(t, mockClient)
 
 		// Prepare the HTTP request
-		payload := marshallPayload(t, types.AppserverSubCreate{AppserverId: "1"})
+		payload := marshallPayload(t, types.AppserverSubCreate{AppserverId: "00000000-0000-0000-0000-000000000001"})
 		req, err := http.NewRequest("POST", url, payload)
 		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
 		req = addContextHeaders(req)
 		rr := httptest.NewRecorder()
 
@@ -89,9 +93,9 @@ func TestCreateAppserverSub(t *testing.T) {
 
 	t.Run("Error:errors_with_invalid_post_parameters", func(t *testing.T) {
 		// ARRANGE
-		expected := marshallResponse(t, api.CreateErrorResponse("Invalid attributes provided."))
+		expected := marshallResponse(t, problem.New(http.StatusUnprocessableEntity, problem.InvalidBody, "Invalid attributes provided."))
 		mockService := new(testutil.MockAppserverSubService)
-		mockCreateRequest := &appserver_sub.CreateRequest{AppserverId: "1"}
+		mockCreateRequest := &appserver_sub.CreateRequest{AppserverId: "00000000-0000-0000-0000-000000000001"}
 		mockResponse := &appserver_sub.CreateResponse{}
 		mockService.On("Create", mock.Anything, mockCreateRequest).Return(mockResponse, nil)
 
@@ -103,6 +107,7 @@ func TestCreateAppserverSub(t *testing.T) {
 		payload := marshallPayload(t, "invalid")
 		req, err := http.NewRequest("POST", url, payload)
 		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
 		req = addContextHeaders(req)
 		rr := httptest.NewRecorder()
 
@@ -118,15 +123,18 @@ func TestCreateAppserverSub(t *testing.T) {
 func TestDeleteAppserverSub(t *testing.T) {
 	log.SetOutput(new(strings.Builder))
 
+	appserverId := "00000000-0000-0000-0000-000000000002"
+	broker := events.NewBroker()
 	r := chi.NewRouter()
+	r.Use(events.Middleware(broker))
 	r.Delete("/{id}", api.AppserverSubDeleteHandler)
 	ts := httptest.NewServer(r)
 	defer ts.Close()
 
 	t.Run("Success:is_successful", func(t *testing.T) {
 		// ARRANGE
-		sId := "1"
-		mockDeleteRequest := &appserver_sub.DeleteRequest{Id: sId}
+		sId := "00000000-0000-0000-0000-000000000001"
+		mockDeleteRequest := &appserver_sub.DeleteRequest{Id: sId, AppserverId: appserverId}
 		mockDeleteResponse := &appserver_sub.DeleteResponse{}
 
 		mockService := new(testutil.MockAppserverSubService)
@@ -134,12 +142,16 @@ func TestDeleteAppserverSub(t *testing.T) {
 			"Delete", mock.Anything, mockDeleteRequest,
 		).Return(mockDeleteResponse, nil)
 
+		kicked := broker.Subscribe("kicked", 10)
+		defer broker.Unsubscribe(kicked)
+		broker.Join(kicked, appserverId, &events.View{})
+
 		mockClient := new(testutil.MockClient)
 		mockClient.On("GetAppserverSubClient").Return(mockService)
 		testutil.MockGrpcClient(t, mockClient)
 
 		// Prepare the HTTP request
-		req, err := http.NewRequest("DELETE", fmt.Sprintf("/%s", sId), nil)
+		req, err := http.NewRequest("DELETE", fmt.Sprintf("/%s?appserver_id=%s", sId, appserverId), nil)
 		require.NoError(t, err)
 		rr := httptest.NewRecorder()
 		req = addContextHeaders(req)
@@ -150,13 +162,18 @@ func TestDeleteAppserverSub(t *testing.T) {
 
 		// ASSERT
 		assert.Equal(t, http.StatusNoContent, rr.Code)
+		e := <-kicked.Events()
+		assert.Equal(t, &types.AppserverSub{ID: sId, AppserverId: appserverId}, e.Data)
+		// the kicked member is unknown, every view is reloaded
+		<-kicked.Refresh()
+		assert.Equal(t, []string{appserverId}, broker.Stale(kicked))
 	})
 
 	t.Run("Error:on_error_when_deleting_returns_error", func(t *testing.T) {
 		// ARRANGE
-		sId := "1"
+		sId := "00000000-0000-0000-0000-000000000001"
 		mockService := new(testutil.MockAppserverSubService)
-		mockDeleteRequest := &appserver_sub.DeleteRequest{Id: sId}
+		mockDeleteRequest := &appserver_sub.DeleteRequest{Id: sId, AppserverId: appserverId}
 		mockResponse := &appserver_sub.DeleteResponse{}
 		mockService.On("Delete", mock.Anything, mockDeleteRequest).Return(mockResponse, errors.New("boom"))
 		mockClient := new(testutil.MockClient)
@@ -164,7 +181,7 @@ func TestDeleteAppserverSub(t *testing.T) {
 		testutil.MockGrpcClient(t, mockClient)
 
 		// Prepare the HTTP request
-		req, err := http.NewRequest("DELETE", fmt.Sprintf("/%s", sId), nil)
+		req, err := http.NewRequest("DELETE", fmt.Sprintf("/%s?appserver_id=%s", sId, appserverId), nil)
 		require.NoError(t, err)
 		rr := httptest.NewRecorder()
 		req = addContextHeaders(req)
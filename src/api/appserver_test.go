@@ -1,6 +1,7 @@
 package api_test
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
@@ -8,6 +9,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/stretchr/testify/assert"
@@ -15,8 +17,11 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"mistapi/src/api"
+	"mistapi/src/problem"
 	"mistapi/src/protos/v1/appserver"
 	"mistapi/src/protos/v1/appserver_role"
 	"mistapi/src/protos/v1/appserver_role_sub"
@@ -34,11 +39,11 @@ func TestListAppservers(t *testing.T) {
 	t.Run("Success:successfully_returns_appservers_and_sub_id", func(t *testing.T) {
 		// ARRANGE
 		servers := []types.AppserverAndSub{
-			{Appserver: types.Appserver{ID: "1", Name: "bar", IsOwner: true}, SubId: "1"},
-			{Appserver: types.Appserver{ID: "1", Name: "bar", IsOwner: true}, SubId: "1"},
+			{Appserver: types.Appserver{ID: "00000000-0000-0000-0000-000000000001", Name: "bar", IsOwner: true}, SubId: "00000000-0000-0000-0000-000000000001"},
+			{Appserver: types.Appserver{ID: "00000000-0000-0000-0000-000000000001", Name: "bar", IsOwner: true}, SubId: "00000000-0000-0000-0000-000000000001"},
 		}
-		expected := marshallResponse(t, api.CreateResponse(servers))
-		mockRequest := &appserver_sub.ListUserServerSubsRequest{}
+		expected := marshallResponse(t, listResponse(servers))
+		mockRequest := &appserver_sub.ListUserServerSubsRequest{PageSize: 50}
 		mockResponse := &appserver_sub.ListUserServerSubsResponse{}
 		mockResponse.Appservers = []*appserver_sub.AppserverAndSub{
 			{
@@ -83,9 +88,9 @@ func TestListAppservers(t *testing.T) {
 
 	t.Run("Error:on_error_returns_error", func(t *testing.T) {
 		// ARRANGE
-		expected := marshallResponse(t, api.CreateErrorResponse("Bad request"))
+		expected := marshallResponse(t, problem.New(http.StatusBadRequest, problem.BadRequest, "Bad request"))
 		mockService := new(testutil.MockAppserverSubService)
-		mockRequest := &appserver_sub.ListUserServerSubsRequest{}
+		mockRequest := &appserver_sub.ListUserServerSubsRequest{PageSize: 50}
 		mockResponse := &appserver_sub.ListUserServerSubsResponse{}
 		mockService.On("ListUserServerSubs", mock.Anything, mockRequest).Return(
 			mockResponse, status.Error(codes.InvalidArgument, "Bad request"))
@@ -119,7 +124,7 @@ func TestCreateAppserver(t *testing.T) {
 	t.Run("Success:successfully_creating_appserver", func(t *testing.T) {
 		// ARRANGE
 		s := &appserver.Appserver{
-			Id:      "1",
+			Id:      "00000000-0000-0000-0000-000000000001",
 			Name:    "foo",
 			IsOwner: true,
 		}
@@ -137,6 +142,7 @@ func TestCreateAppserver(t *testing.T) {
 		payload := marshallPayload(t, types.AppserverCreate{Name: s.Name})
 		req, err := http.NewRequest("POST", "/api/v1/appservers", payload)
 		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
 		req = addContextHeaders(req)
 		rr := httptest.NewRecorder()
 
@@ -150,7 +156,7 @@ func TestCreateAppserver(t *testing.T) {
 
 	t.Run("Error:errors_during_creation_returns_error_status", func(t *testing.T) {
 		// ARRANGE
-		expected := marshallResponse(t, api.CreateErrorResponse("Internal Server Error."))
+		expected := marshallResponse(t, problem.New(http.StatusInternalServerError, problem.Internal, "Internal Server Error."))
 		mockService := new(testutil.MockAppserverService)
 		mockCreateRequest := &appserver.CreateRequest{Name: "foo"}
 		mockResponse := &appserver.CreateResponse{}
@@ -164,6 +170,7 @@ func TestCreateAppserver(t *testing.T) {
 		payload := marshallPayload(t, types.AppserverCreate{Name: "foo"})
 		req, err := http.NewRequest("POST", "/api/v1/appservers", payload)
 		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
 		req = addContextHeaders(req)
 		rr := httptest.NewRecorder()
 
@@ -177,7 +184,7 @@ func TestCreateAppserver(t *testing.T) {
 
 	t.Run("Error:errors_with_invalid_post_parameters", func(t *testing.T) {
 		// ARRANGE
-		expected := marshallResponse(t, api.CreateErrorResponse("Invalid attributes provided."))
+		expected := marshallResponse(t, problem.New(http.StatusUnprocessableEntity, problem.InvalidBody, "Invalid attributes provided."))
 		mockService := new(testutil.MockAppserverService)
 		mockCreateRequest := &appserver.CreateRequest{Name: "foo"}
 		mockResponse := &appserver.CreateResponse{}
@@ -191,6 +198,7 @@ func TestCreateAppserver(t *testing.T) {
 		payload := marshallPayload(t, "invalid")
 		req, err := http.NewRequest("POST", "/api/v1/appservers", payload)
 		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
 		req = addContextHeaders(req)
 		rr := httptest.NewRecorder()
 
@@ -213,7 +221,7 @@ func TestDeleteAppserver(t *testing.T) {
 
 	t.Run("Success:is_successful", func(t *testing.T) {
 		// ARRANGE
-		sId := "1"
+		sId := "00000000-0000-0000-0000-000000000001"
 		mockDeleteRequest := &appserver.DeleteRequest{Id: sId}
 		mockDeleteResponse := &appserver.DeleteResponse{}
 
@@ -242,7 +250,7 @@ func TestDeleteAppserver(t *testing.T) {
 
 	t.Run("Error:on_error_when_deleting_returns_error", func(t *testing.T) {
 		// ARRANGE
-		sId := "1"
+		sId := "00000000-0000-0000-0000-000000000001"
 		mockService := new(testutil.MockAppserverService)
 		mockDeleteRequest := &appserver.DeleteRequest{Id: sId}
 		mockResponse := &appserver.DeleteResponse{}
@@ -278,7 +286,7 @@ func TestAppserverDetailHandler(t *testing.T) {
 
 		// ARRANGE
 		s := types.AppserverDetail{
-			ID:      "1",
+			ID:      "00000000-0000-0000-0000-000000000001",
 			Name:    "Foo",
 			IsOwner: false,
 		}
@@ -294,14 +302,14 @@ func TestAppserverDetailHandler(t *testing.T) {
 		mockRoleRequest := &appserver_role.ListServerRolesRequest{AppserverId: s.ID}
 		mockRoleResponse := &appserver_role.ListServerRolesResponse{
 			AppserverRoles: []*appserver_role.AppserverRole{
-				{Id: "1", Name: "Admin", AppserverId: s.ID},
+				{Id: "00000000-0000-0000-0000-000000000001", Name: "Admin", AppserverId: s.ID},
 			},
 		}
 
 		mockChannelRequest := &channel.ListServerChannelsRequest{AppserverId: s.ID}
 		mockChannelResponse := &channel.ListServerChannelsResponse{
 			Channels: []*channel.Channel{
-				{Id: "1", Name: "Channel1", AppserverId: s.ID},
+				{Id: "00000000-0000-0000-0000-000000000001", Name: "Channel1", AppserverId: s.ID},
 			},
 		}
 
@@ -338,9 +346,9 @@ func TestAppserverDetailHandler(t *testing.T) {
 	t.Run("Error:on_error_returns_error", func(t *testing.T) {
 		// ARRANGE
 		s := types.AppserverDetail{
-			ID: "1",
+			ID: "00000000-0000-0000-0000-000000000001",
 		}
-		expected := marshallResponse(t, api.CreateErrorResponse("Bad request"))
+		expected := marshallResponse(t, problem.New(http.StatusBadRequest, problem.BadRequest, "Bad request"))
 		mockRequest := &appserver.GetByIdRequest{Id: s.ID}
 		mockResponse := &appserver.GetByIdResponse{}
 
@@ -375,16 +383,16 @@ func TestAppserverListSubsHandler(t *testing.T) {
 	ts := httptest.NewServer(r)
 	defer ts.Close()
 
-	sId := "123"
+	sId := "00000000-0000-0000-0000-000000000123"
 
 	t.Run("Success:successfully_returns_appuser_and_sub_ids", func(t *testing.T) {
 
 		// ARRANGE
 		appusers := []types.AppuserAppserverSub{
-			{Appuser: types.Appuser{ID: "1", Username: "foo"}, SubId: "1"},
-			{Appuser: types.Appuser{ID: "2", Username: "bar"}, SubId: "2"},
+			{Appuser: types.Appuser{ID: "00000000-0000-0000-0000-000000000001", Username: "foo"}, SubId: "00000000-0000-0000-0000-000000000001"},
+			{Appuser: types.Appuser{ID: "00000000-0000-0000-0000-000000000002", Username: "bar"}, SubId: "00000000-0000-0000-0000-000000000002"},
 		}
-		expected := marshallResponse(t, api.CreateResponse(appusers))
+		expected := marshallResponse(t, listResponse(appusers))
 		mockResponse := &appserver_sub.ListAppserverUserSubsResponse{}
 		mockResponse.Appusers = []*appserver_sub.AppuserAndSub{
 			{Appuser: &appuser.Appuser{
@@ -399,7 +407,7 @@ func TestAppserverListSubsHandler(t *testing.T) {
 				SubId: appusers[1].SubId},
 		}
 
-		mockRequest := &appserver_sub.ListAppserverUserSubsRequest{AppserverId: sId}
+		mockRequest := &appserver_sub.ListAppserverUserSubsRequest{AppserverId: sId, PageSize: 50}
 
 		mockSubService := new(testutil.MockAppserverSubService)
 		mockSubService.On("ListAppserverUserSubs", mock.Anything, mockRequest).Return(mockResponse, nil)
@@ -424,8 +432,8 @@ func TestAppserverListSubsHandler(t *testing.T) {
 
 	t.Run("Error:on_error_returns_error", func(t *testing.T) {
 		// ARRANGE
-		expected := marshallResponse(t, api.CreateErrorResponse("Bad request"))
-		mockRequest := &appserver_sub.ListAppserverUserSubsRequest{AppserverId: sId}
+		expected := marshallResponse(t, problem.New(http.StatusBadRequest, problem.BadRequest, "Bad request"))
+		mockRequest := &appserver_sub.ListAppserverUserSubsRequest{AppserverId: sId, PageSize: 50}
 		mockSubService := new(testutil.MockAppserverSubService)
 		mockSubService.On(
 			"ListAppserverUserSubs", mock.Anything, mockRequest,
@@ -453,7 +461,7 @@ func TestAppserverListSubsHandler(t *testing.T) {
 func TestAppserverRoleSubListHandler(t *testing.T) {
 	log.SetOutput(new(strings.Builder))
 
-	sId := "123"
+	sId := "00000000-0000-0000-0000-000000000123"
 
 	r := chi.NewRouter()
 	r.Get("/{id}", api.AppserverListRoleSubHandler)
@@ -464,10 +472,10 @@ func TestAppserverRoleSubListHandler(t *testing.T) {
 
 	t.Run("Success:lists_role_subs", func(t *testing.T) {
 		mockService := new(testutil.MockAppserverRoleSubService)
-		mockRequest := &appserver_role_sub.ListServerRoleSubsRequest{AppserverId: sId}
+		mockRequest := &appserver_role_sub.ListServerRoleSubsRequest{AppserverId: sId, PageSize: 50}
 		mockResponse := &appserver_role_sub.ListServerRoleSubsResponse{
 			AppserverRoleSubs: []*appserver_role_sub.AppserverRoleSub{
-				{Id: "1", AppuserId: "user", AppserverRoleId: "role", AppserverId: sId},
+				{Id: "00000000-0000-0000-0000-000000000001", AppuserId: "00000000-0000-0000-0000-000000000a09", AppserverRoleId: "00000000-0000-0000-0000-000000000a0a", AppserverId: sId},
 			},
 		}
 		mockService.On("ListServerRoleSubs", mock.Anything, mockRequest).Return(mockResponse, nil)
@@ -483,8 +491,8 @@ func TestAppserverRoleSubListHandler(t *testing.T) {
 
 		r.ServeHTTP(rr, req)
 
-		expected := marshallResponse(t, api.CreateResponse([]types.AppserverRoleSub{
-			{ID: "1", AppuserId: "user", AppserverRoleId: "role", AppserverId: sId},
+		expected := marshallResponse(t, listResponse([]types.AppserverRoleSub{
+			{ID: "00000000-0000-0000-0000-000000000001", AppuserId: "00000000-0000-0000-0000-000000000a09", AppserverRoleId: "00000000-0000-0000-0000-000000000a0a", AppserverId: sId},
 		}))
 
 		assert.Equal(t, http.StatusOK, rr.Code)
@@ -493,7 +501,7 @@ func TestAppserverRoleSubListHandler(t *testing.T) {
 
 	t.Run("Error:grpc_error", func(t *testing.T) {
 		mockService := new(testutil.MockAppserverRoleSubService)
-		mockRequest := &appserver_role_sub.ListServerRoleSubsRequest{AppserverId: sId}
+		mockRequest := &appserver_role_sub.ListServerRoleSubsRequest{AppserverId: sId, PageSize: 50}
 		mockService.On("ListServerRoleSubs", mock.Anything, mockRequest).Return(
 			nil, status.Error(codes.InvalidArgument, "bad"))
 
@@ -508,7 +516,7 @@ func TestAppserverRoleSubListHandler(t *testing.T) {
 
 		r.ServeHTTP(rr, req)
 
-		expected := marshallResponse(t, api.CreateErrorResponse("bad"))
+		expected := marshallResponse(t, problem.New(http.StatusBadRequest, problem.BadRequest, "bad"))
 		assert.Equal(t, http.StatusBadRequest, rr.Code)
 		assert.JSONEq(t, expected, rr.Body.String())
 	})
@@ -517,7 +525,7 @@ func TestAppserverRoleSubListHandler(t *testing.T) {
 func TestListChannelsHandler(t *testing.T) {
 	log.SetOutput(new(strings.Builder))
 
-	sId := "123"
+	sId := "00000000-0000-0000-0000-000000000123"
 
 	r := chi.NewRouter()
 	r.Get("/{id}", api.AppserverListChannelsHandler)
@@ -529,11 +537,11 @@ func TestListChannelsHandler(t *testing.T) {
 	t.Run("Success:successfully_returns_channels", func(t *testing.T) {
 		// ARRANGE
 		channels := []types.Channel{
-			{ID: "1", Name: "bar", AppserverId: sId},
-			{ID: "2", Name: "bar", AppserverId: sId},
+			{ID: "00000000-0000-0000-0000-000000000001", Name: "bar", AppserverId: sId},
+			{ID: "00000000-0000-0000-0000-000000000002", Name: "bar", AppserverId: sId},
 		}
-		expected := marshallResponse(t, api.CreateResponse(channels))
-		mockRequest := &channel.ListServerChannelsRequest{AppserverId: sId}
+		expected := marshallResponse(t, listResponse(channels))
+		mockRequest := &channel.ListServerChannelsRequest{AppserverId: sId, PageSize: 50}
 		mockResponse := &channel.ListServerChannelsResponse{}
 		mockResponse.Channels = []*channel.Channel{
 			{Id: channels[0].ID, Name: channels[0].Name, AppserverId: channels[0].AppserverId},
@@ -561,9 +569,9 @@ func TestListChannelsHandler(t *testing.T) {
 
 	t.Run("Error:on_error_returns_error", func(t *testing.T) {
 		// ARRANGE
-		expected := marshallResponse(t, api.CreateErrorResponse("Bad request"))
+		expected := marshallResponse(t, problem.New(http.StatusBadRequest, problem.BadRequest, "Bad request"))
 		mockService := new(testutil.MockChannelService)
-		mockRequest := &channel.ListServerChannelsRequest{AppserverId: sId}
+		mockRequest := &channel.ListServerChannelsRequest{AppserverId: sId, PageSize: 50}
 		mockResponse := &channel.ListServerChannelsResponse{}
 		mockService.On("ListServerChannels", mock.Anything, mockRequest).Return(
 			mockResponse, status.Error(codes.InvalidArgument, "Bad request"))
@@ -594,20 +602,30 @@ func TestAppserverListRolesHandler(t *testing.T) {
 	ts := httptest.NewServer(r)
 	defer ts.Close()
 
-	sId := "123"
+	sId := "00000000-0000-0000-0000-000000000123"
 
 	t.Run("Success:successfully_returns_appserver_roles", func(t *testing.T) {
 
 		// ARRANGE
+		noPermissions := types.RolePermissions{
+			AppserverPermissions: []string{}, ChannelPermissions: []string{}, SubPermissions: []string{},
+		}
 		roles := []types.AppserverRole{
-			{ID: "1", Name: "foo", AppserverId: sId},
-			{ID: "2", Name: "bar", AppserverId: sId},
+			{ID: "00000000-0000-0000-0000-000000000001", Name: "foo", AppserverId: sId, RolePermissions: types.RolePermissions{
+				AppserverPermissionMask: 6,
+				ChannelPermissionMask:   1,
+				AppserverPermissions:    []string{"manage_roles", "manage_channels"},
+				ChannelPermissions:      []string{"view_channel"},
+				SubPermissions:          []string{},
+			}},
+			{ID: "00000000-0000-0000-0000-000000000002", Name: "bar", AppserverId: sId, RolePermissions: noPermissions},
 		}
-		expected := marshallResponse(t, api.CreateResponse(roles))
-		mockRequest := &appserver_role.ListServerRolesRequest{AppserverId: sId}
+		expected := marshallResponse(t, listResponse(roles))
+		mockRequest := &appserver_role.ListServerRolesRequest{AppserverId: sId, PageSize: 50}
 		mockResponse := &appserver_role.ListServerRolesResponse{}
 		mockResponse.AppserverRoles = []*appserver_role.AppserverRole{
-			{Id: roles[0].ID, Name: roles[0].Name, AppserverId: roles[0].AppserverId},
+			{Id: roles[0].ID, Name: roles[0].Name, AppserverId: roles[0].AppserverId,
+				AppserverPermissionMask: 6, ChannelPermissionMask: 1},
 			{Id: roles[1].ID, Name: roles[1].Name, AppserverId: roles[1].AppserverId},
 		}
 
@@ -634,10 +652,10 @@ func TestAppserverListRolesHandler(t *testing.T) {
 
 	t.Run("Error:on_error_returns_error", func(t *testing.T) {
 		// ARRANGE
-		expected := marshallResponse(t, api.CreateErrorResponse("Bad request"))
+		expected := marshallResponse(t, problem.New(http.StatusBadRequest, problem.BadRequest, "Bad request"))
 		mockService := new(testutil.MockAppserverRoleService)
 		mockResponse := &appserver_role.ListServerRolesResponse{}
-		mockRequest := &appserver_role.ListServerRolesRequest{AppserverId: sId}
+		mockRequest := &appserver_role.ListServerRolesRequest{AppserverId: sId, PageSize: 50}
 		mockService.On("ListServerRoles", mock.Anything, mockRequest).Return(
 			mockResponse, status.Error(codes.InvalidArgument, "Bad request"),
 		)
@@ -665,8 +683,8 @@ func TestAppserverListRolesHandler(t *testing.T) {
 func TestAppserverChannelRolesHandler(t *testing.T) {
 	log.SetOutput(new(strings.Builder))
 
-	sId := "123"
-	cId := "456"
+	sId := "00000000-0000-0000-0000-000000000123"
+	cId := "00000000-0000-0000-0000-000000000456"
 
 	r := chi.NewRouter()
 	r.Get("/{sid}/channels/{cid}", api.AppserverChannelRolesHandler)
@@ -680,10 +698,11 @@ func TestAppserverChannelRolesHandler(t *testing.T) {
 		mockRequest := &channel_role.ListChannelRolesRequest{
 			ChannelId:   cId,
 			AppserverId: sId,
+			PageSize:    50,
 		}
 		mockResponse := &channel_role.ListChannelRolesResponse{
 			ChannelRoles: []*channel_role.ChannelRole{
-				{Id: "1", ChannelId: cId, AppserverId: sId, AppserverRoleId: "r1"},
+				{Id: "00000000-0000-0000-0000-000000000001", ChannelId: cId, AppserverId: sId, AppserverRoleId: "00000000-0000-0000-0000-000000000a17"},
 			},
 		}
 		mockService.On("ListChannelRoles", mock.Anything, mockRequest).Return(mockResponse, nil)
@@ -699,18 +718,52 @@ func TestAppserverChannelRolesHandler(t *testing.T) {
 
 		r.ServeHTTP(rr, req)
 
-		expected := marshallResponse(t, api.CreateResponse([]types.ChannelRole{
-			{ID: "1", ChannelId: cId, AppserverId: sId, AppserverRoleId: "r1"},
+		expected := marshallResponse(t, listResponse([]types.ChannelRole{
+			{ID: "00000000-0000-0000-0000-000000000001", ChannelId: cId, AppserverId: sId, AppserverRoleId: "00000000-0000-0000-0000-000000000a17"},
 		}))
 		assert.Equal(t, http.StatusOK, rr.Code)
 		assert.JSONEq(t, expected, rr.Body.String())
 	})
 
+	t.Run("Success:passes_the_page_to_the_backend", func(t *testing.T) {
+		// ARRANGE
+		cursors := api.NewCursors("")
+		list := "appservers/" + sId + "/channels/" + cId + "/channel-roles"
+		mockService := new(testutil.MockChannelRoleService)
+		mockService.On("ListChannelRoles", mock.Anything, &channel_role.ListChannelRolesRequest{
+			ChannelId: cId, AppserverId: sId, PageSize: 1, PageToken: "page-2",
+		}).Return(&channel_role.ListChannelRolesResponse{NextPageToken: "page-3"}, nil)
+
+		mockClient := new(testutil.MockClient)
+		mockClient.On("GetChannelRoleClient").Return(mockService)
+		testutil.MockGrpcClient(t, mockClient)
+
+		req, err := http.NewRequest("GET", fullUrl+"?limit=1&cursor="+cursors.Encode(list, "page-2"), nil)
+		require.NoError(t, err)
+		req = addContextHeaders(req)
+		rr := httptest.NewRecorder()
+		cr := chi.NewRouter()
+		cr.Use(api.CursorMiddleware(cursors))
+		cr.Get("/{sid}/channels/{cid}", api.AppserverChannelRolesHandler)
+
+		// ACT
+		cr.ServeHTTP(rr, req)
+
+		// ASSERT
+		var res struct{ Meta api.PageMeta }
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &res))
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.True(t, res.Meta.HasMore)
+		assert.Equal(t, cursors.Encode(list, "page-3"), res.Meta.NextCursor)
+		mockService.AssertExpectations(t)
+	})
+
 	t.Run("Error:grpc_failure", func(t *testing.T) {
 		mockService := new(testutil.MockChannelRoleService)
 		mockReq := &channel_role.ListChannelRolesRequest{
 			ChannelId:   cId,
 			AppserverId: sId,
+			PageSize:    50,
 		}
 		mockService.On("ListChannelRoles", mock.Anything, mockReq).Return(nil, status.Error(codes.InvalidArgument, "invalid"))
 
@@ -725,7 +778,7 @@ func TestAppserverChannelRolesHandler(t *testing.T) {
 
 		r.ServeHTTP(rr, req)
 
-		expected := marshallResponse(t, api.CreateErrorResponse("invalid"))
+		expected := marshallResponse(t, problem.New(http.StatusBadRequest, problem.BadRequest, "invalid"))
 		assert.Equal(t, http.StatusBadRequest, rr.Code)
 		assert.JSONEq(t, expected, rr.Body.String())
 	})
@@ -742,7 +795,7 @@ func TestAppserverChannelRolesHandler(t *testing.T) {
 
 // 	t.Run("Success:is_successful", func(t *testing.T) {
 // 		// ARRANGE
-// 		cId := "1"
+// 		cId := "00000000-0000-0000-0000-000000000001"
 // 		mockDeleteRequest := &channel.DeleteRequest{Id: cId}
 // 		mockDeleteResponse := &channel.DeleteResponse{}
 
@@ -771,7 +824,7 @@ func TestAppserverChannelRolesHandler(t *testing.T) {
 
 // 	t.Run("Error:on_error_when_deleting_returns_error", func(t *testing.T) {
 // 		// ARRANGE
-// 		cId := "1"
+// 		cId := "00000000-0000-0000-0000-000000000001"
 // 		mockService := new(testutil.MockChannelService)
 // 		mockDeleteRequest := &channel.DeleteRequest{Id: cId}
 // 		mockResponse := &channel.DeleteResponse{}
@@ -794,3 +847,238 @@ func TestAppserverChannelRolesHandler(t *testing.T) {
 // 		assert.Equal(t, http.StatusInternalServerError, rr.Code)
 // 	})
 // }
+
+func TestUpdateAppserver(t *testing.T) {
+	log.SetOutput(new(strings.Builder))
+
+	r := chi.NewRouter()
+	r.Patch("/{id}", api.AppserverUpdateHandler)
+
+	readAt := time.Date(2025, 1, 2, 3, 4, 5, 6, time.UTC)
+	updatedAt := readAt.Add(time.Minute)
+
+	t.Run("Success:patched_fields_are_sent_with_their_mask", func(t *testing.T) {
+		// ARRANGE
+		expected := marshallResponse(t, api.CreateResponse(types.Appserver{
+			ID: "00000000-0000-0000-0000-000000000001", Name: "renamed", IsOwner: true, UpdatedAt: &updatedAt,
+		}))
+		mockRequest := &appserver.UpdateRequest{
+			Appserver:         &appserver.Appserver{Id: "00000000-0000-0000-0000-000000000001", Name: "renamed"},
+			UpdateMask:        &fieldmaskpb.FieldMask{Paths: []string{"name"}},
+			ExpectedUpdatedAt: timestamppb.New(readAt),
+		}
+		mockResponse := &appserver.UpdateResponse{Appserver: &appserver.Appserver{
+			Id: "00000000-0000-0000-0000-000000000001", Name: "renamed", IsOwner: true, UpdatedAt: timestamppb.New(updatedAt),
+		}}
+		mockService := new(testutil.MockAppserverService)
+		mockService.On("Update", mock.Anything, mockRequest).Return(mockResponse, nil)
+
+		mockClient := new(testutil.MockClient)
+		mockClient.On("GetAppserverClient").Return(mockService)
+		testutil.MockGrpcClient(t, mockClient)
+
+		req := mergePatchRequest(t, "/00000000-0000-0000-0000-000000000001", `{"name":"renamed","updated_at":"2025-01-02T03:04:05.000000006Z"}`)
+		rr := httptest.NewRecorder()
+
+		// ACT
+		r.ServeHTTP(rr, req)
+
+		// ASSERT
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, expected, rr.Body.String())
+	})
+
+	t.Run("Error:stale_updated_at_returns_precondition_failed", func(t *testing.T) {
+		// ARRANGE
+		mockService := new(testutil.MockAppserverService)
+		mockService.On("Update", mock.Anything, mock.Anything).Return(
+			nil, status.Error(codes.Aborted, "updated_at does not match"))
+
+		mockClient := new(testutil.MockClient)
+		mockClient.On("GetAppserverClient").Return(mockService)
+		testutil.MockGrpcClient(t, mockClient)
+
+		req := mergePatchRequest(t, "/00000000-0000-0000-0000-000000000001", `{"name":"renamed","updated_at":"2025-01-02T03:04:05Z"}`)
+		rr := httptest.NewRecorder()
+
+		// ACT
+		r.ServeHTTP(rr, req)
+
+		// ASSERT
+		assert.Equal(t, http.StatusPreconditionFailed, rr.Code)
+	})
+
+	t.Run("Error:invalid_fields_are_reported", func(t *testing.T) {
+		// ARRANGE
+		expected := problem.New(http.StatusBadRequest, problem.ValidationFailed, "Invalid patch.")
+		expected.Errors = []api.FieldError{
+			{Field: "name", Message: "cannot be null"},
+			{Field: "updated_at", Message: "has an invalid value"},
+			{Field: "id", Message: "cannot be updated"},
+			{Field: "is_owner", Message: "cannot be updated"},
+		}
+		mockClient := new(testutil.MockClient)
+		testutil.MockGrpcClient(t, mockClient)
+
+		req := mergePatchRequest(t, "/00000000-0000-0000-0000-000000000001", `{"name":null,"id":"00000000-0000-0000-0000-000000000002","is_owner":true,"updated_at":"yesterday"}`)
+		rr := httptest.NewRecorder()
+
+		// ACT
+		r.ServeHTTP(rr, req)
+
+		// ASSERT
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.JSONEq(t, marshallResponse(t, expected), rr.Body.String())
+		mockClient.AssertNotCalled(t, "GetAppserverClient")
+	})
+
+	t.Run("Error:unsupported_content_type_is_rejected", func(t *testing.T) {
+		// ARRANGE
+		req := mergePatchRequest(t, "/00000000-0000-0000-0000-000000000001", `name=renamed`)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		rr := httptest.NewRecorder()
+
+		// ACT
+		r.ServeHTTP(rr, req)
+
+		// ASSERT
+		assert.Equal(t, http.StatusUnsupportedMediaType, rr.Code)
+	})
+
+	names := []struct {
+		name    string
+		value   string
+		message string
+	}{
+		{"Error:empty_name_is_rejected", "", "value length must be at least 1 characters"},
+		{"Error:name_over_64_characters_is_rejected", strings.Repeat("a", 65), "value length must be at most 64 characters"},
+	}
+
+	for _, tt := range names {
+		t.Run(tt.name, func(t *testing.T) {
+			// ARRANGE
+			expected := problem.New(http.StatusBadRequest, problem.ValidationFailed, "Invalid patch.")
+			expected.Errors = []api.FieldError{{Field: "name", Message: tt.message}}
+			mockClient := new(testutil.MockClient)
+			testutil.MockGrpcClient(t, mockClient)
+
+			req := mergePatchRequest(t, "/00000000-0000-0000-0000-000000000001", `{"name":"`+tt.value+`"}`)
+			rr := httptest.NewRecorder()
+
+			// ACT
+			r.ServeHTTP(rr, req)
+
+			// ASSERT
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+			assert.JSONEq(t, marshallResponse(t, expected), rr.Body.String())
+			mockClient.AssertNotCalled(t, "GetAppserverClient")
+		})
+	}
+}
+
+func TestAppserverChannelDetailHandler(t *testing.T) {
+	log.SetOutput(new(strings.Builder))
+
+	r := chi.NewRouter()
+	r.Get("/{sid}/channels/{cid}", api.AppserverChannelDetailHandler)
+
+	createdAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	updatedAt := createdAt.Add(time.Hour)
+	mockRequest := &channel.GetByIdRequest{Id: "00000000-0000-0000-0000-000000000a15", AppserverId: "00000000-0000-0000-0000-000000000a13"}
+
+	t.Run("Success:returns_channel_with_its_roles", func(t *testing.T) {
+		// ARRANGE
+		expected := marshallResponse(t, api.CreateResponse(types.ChannelDetail{
+			ID:          "00000000-0000-0000-0000-000000000a15",
+			Name:        "staff",
+			AppserverId: "00000000-0000-0000-0000-000000000a13",
+			IsPrivate:   true,
+			CreatedAt:   &createdAt,
+			UpdatedAt:   &updatedAt,
+			Roles: []types.ChannelRole{
+				{ID: "00000000-0000-0000-0000-000000000a19", ChannelId: "00000000-0000-0000-0000-000000000a15", AppserverId: "00000000-0000-0000-0000-000000000a13", AppserverRoleId: "00000000-0000-0000-0000-000000000a17"},
+			},
+		}))
+		mockChannel := new(testutil.MockChannelService)
+		mockChannel.On("GetById", mock.Anything, mockRequest).Return(&channel.GetByIdResponse{Channel: &channel.Channel{
+			Id:          "00000000-0000-0000-0000-000000000a15",
+			Name:        "staff",
+			AppserverId: "00000000-0000-0000-0000-000000000a13",
+			IsPrivate:   true,
+			CreatedAt:   timestamppb.New(createdAt),
+			UpdatedAt:   timestamppb.New(updatedAt),
+		}}, nil)
+
+		mockChannelRoles := new(testutil.MockChannelRoleService)
+		mockChannelRoles.On("ListChannelRoles", mock.Anything,
+			&channel_role.ListChannelRolesRequest{ChannelId: "00000000-0000-0000-0000-000000000a15", AppserverId: "00000000-0000-0000-0000-000000000a13"}).Return(
+			&channel_role.ListChannelRolesResponse{ChannelRoles: []*channel_role.ChannelRole{
+				{Id: "00000000-0000-0000-0000-000000000a19", ChannelId: "00000000-0000-0000-0000-000000000a15", AppserverId: "00000000-0000-0000-0000-000000000a13", AppserverRoleId: "00000000-0000-0000-0000-000000000a17"},
+			}}, nil)
+
+		mockClient := new(testutil.MockClient)
+		mockClient.On("GetChannelClient").Return(mockChannel)
+		mockClient.On("GetChannelRoleClient").Return(mockChannelRoles)
+		testutil.MockGrpcClient(t, mockClient)
+
+		req, err := http.NewRequest("GET", "/00000000-0000-0000-0000-000000000a13/channels/00000000-0000-0000-0000-000000000a15", nil)
+		require.NoError(t, err)
+		req = addContextHeaders(req)
+		rr := httptest.NewRecorder()
+
+		// ACT
+		r.ServeHTTP(rr, req)
+
+		// ASSERT
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, expected, rr.Body.String())
+	})
+
+	t.Run("Error:missing_channel_returns_not_found", func(t *testing.T) {
+		// ARRANGE
+		expected := marshallResponse(t, problem.New(http.StatusNotFound, problem.NotFound, "Not found."))
+		mockChannel := new(testutil.MockChannelService)
+		mockChannel.On("GetById", mock.Anything, mockRequest).Return(nil, status.Error(codes.NotFound, "missing"))
+
+		mockClient := new(testutil.MockClient)
+		mockClient.On("GetChannelClient").Return(mockChannel)
+		testutil.MockGrpcClient(t, mockClient)
+
+		req, err := http.NewRequest("GET", "/00000000-0000-0000-0000-000000000a13/channels/00000000-0000-0000-0000-000000000a15", nil)
+		require.NoError(t, err)
+		req = addContextHeaders(req)
+		rr := httptest.NewRecorder()
+
+		// ACT
+		r.ServeHTTP(rr, req)
+
+		// ASSERT
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+		assert.JSONEq(t, expected, rr.Body.String())
+		mockClient.AssertNotCalled(t, "GetChannelRoleClient")
+	})
+
+	t.Run("Error:channel_of_another_appserver_returns_not_found", func(t *testing.T) {
+		// ARRANGE
+		mockChannel := new(testutil.MockChannelService)
+		mockChannel.On("GetById", mock.Anything, mockRequest).Return(&channel.GetByIdResponse{Channel: &channel.Channel{
+			Id: "00000000-0000-0000-0000-000000000a15", AppserverId: "00000000-0000-0000-0000-000000000a14",
+		}}, nil)
+
+		mockClient := new(testutil.MockClient)
+		mockClient.On("GetChannelClient").Return(mockChannel)
+		testutil.MockGrpcClient(t, mockClient)
+
+		req, err := http.NewRequest("GET", "/00000000-0000-0000-0000-000000000a13/channels/00000000-0000-0000-0000-000000000a15", nil)
+		require.NoError(t, err)
+		req = addContextHeaders(req)
+		rr := httptest.NewRecorder()
+
+		// ACT
+		r.ServeHTTP(rr, req)
+
+		// ASSERT
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+		mockClient.AssertNotCalled(t, "GetChannelRoleClient")
+	})
+}
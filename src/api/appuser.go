@@ -1 +1,130 @@
 package api
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"mistapi/src/auth"
+	"mistapi/src/config"
+	"mistapi/src/logging"
+	"mistapi/src/protos/v1/appuser"
+	"mistapi/src/service"
+	"mistapi/src/types"
+
+	"github.com/go-chi/render"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxProvisionedUsers bounds the memory used to remember provisioned users.
+const maxProvisionedUsers = 100_000
+
+// MeHandler godoc
+// @Summary      Get the current user
+// @Description  Get the user the token was issued to
+// @Tags         appuser
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} types.Appuser
+// @Router       /api/v1/me [get]
+func MeHandler(usernameClaim string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		authT, _ := auth.GetAuthotizationToken(r)
+
+		render.JSON(w, r, CreateResponse(&types.Appuser{
+			ID:       authT.Claims.UserID,
+			Username: auth.StringClaim(authT, usernameClaim),
+		}))
+	}
+}
+
+// ProvisionMiddleware creates the appuser of the token user_id on the first
+// request of a user, the ID /me and the rest of the gateway use. Users are remembered for cfg.Provisioning.CacheTTL, so
+// later requests skip the backend call.
+func ProvisionMiddleware(cfg config.AuthConfig) func(http.Handler) http.Handler {
+	users := newProvisionedUsers(cfg.Provisioning.CacheTTL)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authT, err := auth.GetAuthotizationToken(r)
+			if err != nil || users.known(authT.Claims.UserID) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			id, username := authT.Claims.UserID, auth.StringClaim(authT, cfg.UsernameClaim)
+			if id == "" || username == "" {
+				logging.FromContext(r.Context()).Warn("Cannot provision user, token lacks user_id or username",
+					"username_claim", cfg.UsernameClaim)
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			req := &appuser.CreateRequest{
+				Id:       id,
+				Username: username,
+			}
+			if !validateRequest(w, r, req) {
+				return
+			}
+
+			ctx, cancel := service.SetupGrpcHeaders(r.Context(), authT.Token, BackendTimeout(r))
+			defer cancel()
+
+			c := service.NewGrpcClient()
+			_, err = c.GetAppuserClient().Create(ctx, req)
+
+			// concurrent first requests race to create the user, the losers
+			// see AlreadyExists
+			if err != nil && status.Code(err) != codes.AlreadyExists {
+				HandleGrpcError(w, r, err)
+				return
+			}
+			if err == nil {
+				logging.FromContext(r.Context()).Info("Provisioned user", "user_id", id)
+			}
+
+			users.add(id)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// provisionedUsers remembers the users known to exist in the backend.
+type provisionedUsers struct {
+	mu   sync.Mutex
+	ttl  time.Duration
+	seen map[string]time.Time
+}
+
+func newProvisionedUsers(ttl time.Duration) *provisionedUsers {
+	return &provisionedUsers{ttl: ttl, seen: map[string]time.Time{}}
+}
+
+func (p *provisionedUsers) known(id string) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	expires, ok := p.seen[id]
+	return ok && time.Now().Before(expires)
+}
+
+func (p *provisionedUsers) add(id string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	if len(p.seen) >= maxProvisionedUsers {
+		for k, expires := range p.seen {
+			if !now.Before(expires) {
+				delete(p.seen, k)
+			}
+		}
+		// still full, forgetting users only costs an extra Create call
+		if len(p.seen) >= maxProvisionedUsers {
+			p.seen = map[string]time.Time{}
+		}
+	}
+	p.seen[id] = now.Add(p.ttl)
+}
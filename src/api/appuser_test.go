@@ -0,0 +1,159 @@
+package api_test
+
+import (
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"mistapi/src/api"
+	"mistapi/src/config"
+	"mistapi/src/protos/v1/appuser"
+	"mistapi/src/testutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// provisionedUser is the user_id of the tokens in these tests.
+const provisionedUser = "00000000-0000-0000-0000-000000000a01"
+
+func provisioningConfig() *config.Config {
+	cfg := testConfig()
+	cfg.Auth.UsernameClaim = "username"
+	cfg.Auth.Provisioning = config.ProvisioningConfig{Enabled: true, CacheTTL: time.Hour}
+	return cfg
+}
+
+func getMe(t *testing.T, r http.Handler, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/me", nil)
+	req.Header.Set("Authorization", token)
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, req)
+	return rr
+}
+
+func TestMeHandler(t *testing.T) {
+	log.SetOutput(new(strings.Builder))
+
+	t.Run("Success:returns_the_token_user", func(t *testing.T) {
+		// ARRANGE
+		testutil.MockGrpcClient(t, new(testutil.MockClient))
+		cfg := testConfig()
+		cfg.Auth.UsernameClaim = "username"
+		r := api.SetupRouter(cfg, api.NewReadiness(0, time.Second, nil), testVerifier(t))
+
+		// ACT
+		rr := getMe(t, r, signedTestToken(t, provisionedUser, tokenClaims{Username: "alice"}))
+
+		// ASSERT
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{"data":{"id":"00000000-0000-0000-0000-000000000a01","username":"alice"}}`, rr.Body.String())
+	})
+}
+
+func TestProvisionMiddleware(t *testing.T) {
+	log.SetOutput(new(strings.Builder))
+
+	createRequest := &appuser.CreateRequest{Id: provisionedUser, Username: "alice"}
+
+	setup := func(t *testing.T, err error) (http.Handler, *testutil.MockAppuserService) {
+		mockService := new(testutil.MockAppuserService)
+		mockService.On("Create", mock.Anything, createRequest).Return(&appuser.CreateResponse{}, err)
+
+		mockClient := new(testutil.MockClient)
+		mockClient.On("GetAppuserClient").Return(mockService)
+		testutil.MockGrpcClient(t, mockClient)
+
+		r := api.SetupRouter(provisioningConfig(), api.NewReadiness(0, time.Second, nil), testVerifier(t))
+		return r, mockService
+	}
+
+	t.Run("Success:user_is_created_once", func(t *testing.T) {
+		// ARRANGE
+		r, mockService := setup(t, nil)
+		token := signedTestToken(t, provisionedUser, tokenClaims{Username: "alice"})
+
+		// ACT
+		first := getMe(t, r, token)
+		second := getMe(t, r, token)
+
+		// ASSERT
+		assert.Equal(t, http.StatusOK, first.Code)
+		assert.Equal(t, http.StatusOK, second.Code)
+		mockService.AssertNumberOfCalls(t, "Create", 1)
+	})
+
+	t.Run("Success:existing_user_is_remembered", func(t *testing.T) {
+		// ARRANGE
+		r, mockService := setup(t, status.Error(codes.AlreadyExists, "exists"))
+		token := signedTestToken(t, provisionedUser, tokenClaims{Username: "alice"})
+
+		// ACT
+		first := getMe(t, r, token)
+		second := getMe(t, r, token)
+
+		// ASSERT
+		assert.Equal(t, http.StatusOK, first.Code)
+		assert.Equal(t, http.StatusOK, second.Code)
+		mockService.AssertNumberOfCalls(t, "Create", 1)
+	})
+
+	t.Run("Error:backend_failure_fails_the_request_and_is_retried", func(t *testing.T) {
+		// ARRANGE
+		r, mockService := setup(t, status.Error(codes.Unavailable, "down"))
+		token := signedTestToken(t, provisionedUser, tokenClaims{Username: "alice"})
+
+		// ACT
+		first := getMe(t, r, token)
+		second := getMe(t, r, token)
+
+		// ASSERT
+		assert.Equal(t, http.StatusBadGateway, first.Code)
+		assert.Equal(t, http.StatusBadGateway, second.Code)
+		mockService.AssertNumberOfCalls(t, "Create", 2)
+	})
+
+	t.Run("Success:token_without_username_is_not_provisioned", func(t *testing.T) {
+		// ARRANGE
+		r, mockService := setup(t, nil)
+
+		// ACT
+		rr := getMe(t, r, signedTestToken(t, provisionedUser))
+
+		// ASSERT
+		require.Equal(t, http.StatusOK, rr.Code)
+		mockService.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Success:user_id_is_provisioned_rather_than_the_subject", func(t *testing.T) {
+		// ARRANGE
+		r, mockService := setup(t, nil)
+
+		// ACT
+		rr := getMe(t, r, signedTestToken(t, provisionedUser, tokenClaims{Subject: "auth0|alice", Username: "alice"}))
+
+		// ASSERT
+		assert.Equal(t, http.StatusOK, rr.Code)
+		mockService.AssertCalled(t, "Create", mock.Anything, createRequest)
+	})
+
+	t.Run("Error:invalid_user_is_not_sent_to_the_backend", func(t *testing.T) {
+		// ARRANGE
+		r, mockService := setup(t, nil)
+
+		// ACT
+		rr := getMe(t, r, signedTestToken(t, provisionedUser, tokenClaims{Username: strings.Repeat("a", 256)}))
+
+		// ASSERT
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"field":"username"`)
+		mockService.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+}
@@ -0,0 +1,279 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+
+	"mistapi/src/logging"
+	"mistapi/src/permissions"
+	"mistapi/src/problem"
+)
+
+const jsonContentType = "application/json"
+
+var (
+	errUnsupportedMediaType = errors.New("unsupported media type")
+	errEmptyBody            = errors.New("empty body")
+	errMissingFields        = errors.New("missing required fields")
+)
+
+// trailingDataError reports data found after the JSON value of a body.
+type trailingDataError struct {
+	offset int64
+}
+
+func (e *trailingDataError) Error() string {
+	return fmt.Sprintf("unexpected data after the JSON value at offset %d", e.offset)
+}
+
+// BodyLimitMiddleware rejects request bodies larger than limit bytes. Bodies
+// declaring a larger Content-Length are refused right away, the decoders
+// answer 413 for the others once they go over it.
+func BodyLimitMiddleware(limit int) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.ContentLength > int64(limit) {
+				renderTooLarge(w, r, int64(limit))
+				return
+			}
+			if r.Body != nil {
+				r.Body = http.MaxBytesReader(w, r.Body, int64(limit))
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// DecodeRequestBody decodes the application/json body of r into a T. Unknown
+// fields, trailing data and missing fields tagged validate:"required" are
+// rejected. Malformed JSON gets a 400 and JSON not matching T a 422; the
+// error response is written before the error is returned.
+func DecodeRequestBody[T any](w http.ResponseWriter, r *http.Request) (T, error) {
+	var v T
+	body, err := readBody(w, r, jsonContentType)
+	if err != nil {
+		return v, err
+	}
+
+	if err := decodeStrict(body, &v); err != nil {
+		renderDecodeError(w, r, err, nil)
+		return v, err
+	}
+
+	if missing := missingFields(body, reflect.TypeOf(v)); len(missing) > 0 {
+		renderDecodeError(w, r, errMissingFields, missing)
+		return v, errMissingFields
+	}
+	return v, nil
+}
+
+type bodyKey struct{}
+
+// decodeBody decodes the JSON body into a T ahead of the handler, which takes
+// it with requestBody. Permissions checked in between, through
+// bodyAppserver, then apply to the very value sent to the backend.
+func decodeBody[T any](next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		v, err := DecodeRequestBody[T](w, r)
+		if err != nil {
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), bodyKey{}, v)))
+	})
+}
+
+// requestBody returns the body decoded by decodeBody, or decodes it when the
+// route has no decodeBody.
+func requestBody[T any](w http.ResponseWriter, r *http.Request) (T, error) {
+	if v, ok := r.Context().Value(bodyKey{}).(T); ok {
+		return v, nil
+	}
+	return DecodeRequestBody[T](w, r)
+}
+
+// bodyAppserver reads the appserver of the body decoded by decodeBody.
+func bodyAppserver[T any](appserverID func(T) string) permissions.AppserverID {
+	return func(r *http.Request) string {
+		v, _ := r.Context().Value(bodyKey{}).(T)
+		return appserverID(v)
+	}
+}
+
+// readBody checks the media type of r and reads its body. It renders the
+// error response when the body cannot be used.
+func readBody(w http.ResponseWriter, r *http.Request, mediaTypes ...string) ([]byte, error) {
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if !slices.Contains(mediaTypes, mediaType) {
+		RenderError(w, r, http.StatusUnsupportedMediaType, problem.UnsupportedMediaType,
+			fmt.Sprintf("Content-Type must be %s.", mediaTypes[0]))
+		return nil, errUnsupportedMediaType
+	}
+
+	if r.Body == nil {
+		renderDecodeError(w, r, errEmptyBody, nil)
+		return nil, errEmptyBody
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			renderTooLarge(w, r, tooLarge.Limit)
+			return nil, err
+		}
+		logging.FromContext(r.Context()).Info("Error while reading request body", "error", err)
+		RenderError(w, r, http.StatusBadRequest, problem.MalformedBody, "Request body could not be read.")
+		return nil, err
+	}
+	if len(bytes.TrimSpace(body)) == 0 {
+		renderDecodeError(w, r, errEmptyBody, nil)
+		return nil, errEmptyBody
+	}
+	return body, nil
+}
+
+// decodeStrict decodes the single JSON value of body into v, refusing fields
+// v does not have.
+func decodeStrict(body []byte, v any) error {
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		return err
+	}
+
+	offset := dec.InputOffset()
+	if _, err := dec.Token(); err != io.EOF {
+		return &trailingDataError{offset: offset}
+	}
+	return nil
+}
+
+// renderDecodeError answers 400 for bodies that are not JSON and 422 for JSON
+// that does not fit the expected type, pointing at the offending field or
+// offset.
+func renderDecodeError(w http.ResponseWriter, r *http.Request, err error, errs []FieldError) {
+	logging.FromContext(r.Context()).Info("Error while decoding request body", "error", err)
+
+	var (
+		syntaxErr   *json.SyntaxError
+		typeErr     *json.UnmarshalTypeError
+		trailingErr *trailingDataError
+	)
+	switch {
+	case errors.Is(err, errEmptyBody), errors.Is(err, io.EOF):
+		RenderError(w, r, http.StatusBadRequest, problem.MalformedBody, "Request body must not be empty.")
+		return
+	case errors.Is(err, io.ErrUnexpectedEOF):
+		RenderError(w, r, http.StatusBadRequest, problem.MalformedBody, "Malformed JSON, the body ends early.")
+		return
+	case errors.As(err, &syntaxErr):
+		RenderError(w, r, http.StatusBadRequest, problem.MalformedBody,
+			fmt.Sprintf("Malformed JSON at offset %d.", syntaxErr.Offset))
+		return
+	case errors.As(err, &trailingErr):
+		RenderError(w, r, http.StatusBadRequest, problem.MalformedBody,
+			fmt.Sprintf("Unexpected data after the JSON body at offset %d.", trailingErr.offset))
+		return
+	case errors.As(err, &typeErr) && typeErr.Field != "":
+		errs = []FieldError{{Field: typeErr.Field, Message: "must be " + jsonKind(typeErr.Type)}}
+	default:
+		// encoding/json has no error type for unknown fields
+		if field, ok := strings.CutPrefix(err.Error(), "json: unknown field "); ok {
+			errs = []FieldError{{Field: strings.Trim(field, `"`), Message: "is not allowed"}}
+		}
+	}
+
+	res := problem.New(http.StatusUnprocessableEntity, problem.InvalidBody, "Invalid attributes provided.")
+	res.Errors = errs
+	problem.Write(w, r, res)
+}
+
+func renderTooLarge(w http.ResponseWriter, r *http.Request, limit int64) {
+	RenderError(w, r, http.StatusRequestEntityTooLarge, problem.PayloadTooLarge,
+		fmt.Sprintf("Request body must not exceed %d bytes.", limit))
+}
+
+// jsonKind names the JSON type decoded into t.
+func jsonKind(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.String:
+		return "a string"
+	case reflect.Bool:
+		return "a boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "an integer"
+	case reflect.Float32, reflect.Float64:
+		return "a number"
+	case reflect.Slice, reflect.Array:
+		return "an array"
+	default:
+		return "an object"
+	}
+}
+
+// missingFields returns the fields of t tagged validate:"required" that body
+// leaves out or sets to null. Like encoding/json, names match case
+// insensitively.
+func missingFields(body []byte, t reflect.Type) []FieldError {
+	required := requiredFields(t)
+	if len(required) == 0 {
+		return nil
+	}
+
+	var present map[string]json.RawMessage
+	json.Unmarshal(body, &present)
+
+	var errs []FieldError
+	for _, name := range required {
+		found := false
+		for key, raw := range present {
+			if strings.EqualFold(key, name) && !bytes.Equal(raw, []byte("null")) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			errs = append(errs, FieldError{Field: name, Message: "is required"})
+		}
+	}
+	return errs
+}
+
+// requiredFields returns the JSON names of the fields of t tagged
+// validate:"required", including the ones of embedded structs.
+func requiredFields(t reflect.Type) []string {
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var names []string
+	for i := range t.NumField() {
+		f := t.Field(i)
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if f.Anonymous && name == "" {
+			names = append(names, requiredFields(f.Type)...)
+			continue
+		}
+		if !slices.Contains(strings.Split(f.Tag.Get("validate"), ","), "required") {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		names = append(names, name)
+	}
+	return names
+}
@@ -0,0 +1,142 @@
+package api_test
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"mistapi/src/api"
+	"mistapi/src/types"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeRequestBody(t *testing.T) {
+	log.SetOutput(new(strings.Builder))
+
+	r := chi.NewRouter()
+	r.Use(api.BodyLimitMiddleware(64))
+	r.Post("/", func(w http.ResponseWriter, r *http.Request) {
+		c, err := api.DecodeRequestBody[types.ChannelCreate](w, r)
+		if err != nil {
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(c)
+	})
+
+	post := func(contentType, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		req.Header.Set("Content-Type", contentType)
+		rr := httptest.NewRecorder()
+		r.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("Success:body_is_decoded", func(t *testing.T) {
+		// ACT
+		rr := post("application/json; charset=utf-8", `{"name":"general","appserver_id":"a1"}`)
+
+		// ASSERT
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{"name":"general","appserver_id":"a1"}`, rr.Body.String())
+	})
+
+	t.Run("Error:content_type_must_be_json", func(t *testing.T) {
+		// ACT
+		rr := post("text/plain", `{"name":"general","appserver_id":"a1"}`)
+
+		// ASSERT
+		assert.Equal(t, http.StatusUnsupportedMediaType, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"detail":"Content-Type must be application/json."`)
+	})
+
+	t.Run("Error:body_over_the_limit_is_rejected", func(t *testing.T) {
+		// ACT
+		rr := post("application/json", `{"name":"`+strings.Repeat("a", 64)+`","appserver_id":"a1"}`)
+
+		// ASSERT
+		assert.Equal(t, http.StatusRequestEntityTooLarge, rr.Code)
+		assert.JSONEq(t, `{"type":"urn:mist:problem:payload_too_large","title":"Payload too large","status":413,
+			"code":"payload_too_large","detail":"Request body must not exceed 64 bytes."}`, rr.Body.String())
+	})
+
+	t.Run("Error:chunked_body_over_the_limit_is_rejected", func(t *testing.T) {
+		// ARRANGE
+		body := `{"name":"` + strings.Repeat("a", 64) + `","appserver_id":"a1"}`
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.ContentLength = -1
+		rr := httptest.NewRecorder()
+
+		// ACT
+		r.ServeHTTP(rr, req)
+
+		// ASSERT
+		assert.Equal(t, http.StatusRequestEntityTooLarge, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"detail":"Request body must not exceed 64 bytes."`)
+	})
+
+	t.Run("Error:malformed_json_reports_the_offset", func(t *testing.T) {
+		// ACT
+		rr := post("application/json", `{"name":"general",}`)
+
+		// ASSERT
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.JSONEq(t, `{"type":"urn:mist:problem:malformed_body","title":"Malformed request body","status":400,
+			"code":"malformed_body","detail":"Malformed JSON at offset 19."}`, rr.Body.String())
+	})
+
+	t.Run("Error:empty_body_is_rejected", func(t *testing.T) {
+		// ACT
+		rr := post("application/json", ``)
+
+		// ASSERT
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"detail":"Request body must not be empty."`)
+	})
+
+	t.Run("Error:trailing_data_is_rejected", func(t *testing.T) {
+		// ACT
+		rr := post("application/json", `{"name":"general","appserver_id":"a1"} {}`)
+
+		// ASSERT
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"detail":"Unexpected data after the JSON body at offset 38."`)
+	})
+
+	t.Run("Error:unknown_field_is_rejected", func(t *testing.T) {
+		// ACT
+		rr := post("application/json", `{"name":"general","appserver_id":"a1","topic":"x"}`)
+
+		// ASSERT
+		assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+		assert.JSONEq(t, `{"type":"urn:mist:problem:invalid_body","title":"Invalid request body","status":422,
+			"code":"invalid_body","detail":"Invalid attributes provided.","errors":[
+			{"field":"topic","message":"is not allowed"}
+		]}`, rr.Body.String())
+	})
+
+	t.Run("Error:wrong_type_names_the_field", func(t *testing.T) {
+		// ACT
+		rr := post("application/json", `{"name":1,"appserver_id":"a1"}`)
+
+		// ASSERT
+		assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"errors":[{"field":"name","message":"must be a string"}]`)
+	})
+
+	t.Run("Error:missing_required_fields_are_listed", func(t *testing.T) {
+		// ACT
+		rr := post("application/json", `{"name":null,"is_private":true}`)
+
+		// ASSERT
+		assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"errors":[`+
+			`{"field":"name","message":"is required"},{"field":"appserver_id","message":"is required"}]`)
+	})
+}
@@ -4,6 +4,8 @@ import (
 	"net/http"
 
 	"mistapi/src/auth"
+	"mistapi/src/events"
+	"mistapi/src/permissions"
 	"mistapi/src/protos/v1/channel"
 	"mistapi/src/service"
 	"mistapi/src/types"
@@ -15,7 +17,11 @@ import (
 func channelRouter() http.Handler {
 	r := chi.NewRouter()
 
-	r.Post("/", ChannelCreateHandler) // create a channel
+	r.With(decodeBody[types.ChannelCreate],
+		requirePermissions(bodyAppserver(func(c types.ChannelCreate) string { return c.AppserverId }), permissions.ManageChannels)).
+		Post("/", ChannelCreateHandler) // create a channel
+	r.With(ValidatePathParams, decodePatch, requirePermissions(patchAppserver, permissions.ManageChannels)).
+		Patch("/{id}", ChannelUpdateHandler) // update a channel
 	return r
 }
 
@@ -30,36 +36,116 @@ func channelRouter() http.Handler {
 // @Success      201 {object} types.Channel
 // @Router       /api/v1/channels [post]
 func ChannelCreateHandler(w http.ResponseWriter, r *http.Request) {
-	var c types.ChannelCreate
+	c, err := requestBody[types.ChannelCreate](w, r)
+	if err != nil {
+		return
+	}
+
+	req := &channel.CreateRequest{
+		Name:        c.Name,
+		AppserverId: c.AppserverId,
+		IsPrivate:   c.IsPrivate,
+	}
+	if !validateRequest(w, r, req) {
+		return
+	}
+
+	authT, _ := auth.GetAuthotizationToken(r)
+	ctx, cancel := service.SetupGrpcHeaders(r.Context(), authT.Token, BackendTimeout(r))
+	defer cancel()
 
-	err := DecodeRequestBody(w, r, &c)
+	client := service.NewGrpcClient()
+	response, err := client.GetChannelClient().Create(ctx, req)
 
 	if err != nil {
+		HandleGrpcError(w, r, err)
+		return
+	}
+
+	ch := channelFromProto(response.Channel)
+	events.Publish(r.Context(), events.Event{
+		Type:        events.ChannelCreated,
+		AppserverID: ch.AppserverId,
+		ChannelID:   ch.ID,
+		Data:        ch,
+		Private:     &ch.IsPrivate,
+	})
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, CreateResponse(ch))
+}
+
+// ChannelUpdateHandler godoc
+// @Summary      Update a channel
+// @Description  Apply a JSON merge patch to a channel, keeping its channel roles. appserver_id is
+// @Description  required and cannot be changed. When updated_at is given the update only succeeds
+// @Description  if the channel has not changed since, otherwise 412 is returned.
+// @Tags         channel
+// @Accept       json
+// @Produce      json
+// @Param        id     path      string              true  "Channel ID"
+// @Param        patch  body      types.ChannelPatch  true  "ChannelPatch"
+// @Security     BearerAuth
+// @Success      200 {object} types.Channel
+// @Failure      400 {object} ErrorResponse
+// @Failure      412 {object} ErrorResponse
+// @Router       /api/v1/channels/{id} [patch]
+func ChannelUpdateHandler(w http.ResponseWriter, r *http.Request) {
+	cId := chi.URLParam(r, "id")
+
+	patch, ok := decodeMergePatch(w, r)
+	if !ok {
+		return
+	}
+
+	ch := &channel.Channel{Id: cId}
+	patch.key("appserver_id", &ch.AppserverId)
+	patch.name("name", &ch.Name, "name")
+	patch.take("is_private", &ch.IsPrivate, "is_private", true)
+	updatedAt := patch.updatedAt()
+	if !patch.done(w, r) {
+		return
+	}
+
+	req := &channel.UpdateRequest{
+		Channel:           ch,
+		UpdateMask:        patch.mask(),
+		ExpectedUpdatedAt: updatedAt,
+	}
+	if !validateRequest(w, r, req) {
 		return
 	}
 
 	authT, _ := auth.GetAuthotizationToken(r)
-	ctx, cancel := service.SetupGrpcHeaders(authT.Token)
+	ctx, cancel := service.SetupGrpcHeaders(r.Context(), authT.Token, BackendTimeout(r))
 	defer cancel()
 
 	client := service.NewGrpcClient()
-	response, err := client.GetChannelClient().Create(
-		ctx, &channel.CreateRequest{
-			Name:        c.Name,
-			AppserverId: c.AppserverId,
-			IsPrivate:   c.IsPrivate,
-		},
-	)
+	response, err := client.GetChannelClient().Update(ctx, req)
 
 	if err != nil {
 		HandleGrpcError(w, r, err)
 		return
 	}
 
-	render.Status(r, http.StatusCreated)
-	render.JSON(w, r, CreateResponse(&types.Channel{
-		ID:          response.Channel.Id,
-		Name:        response.Channel.Name,
-		AppserverId: response.Channel.AppserverId,
-	}))
+	updated := channelFromProto(response.Channel)
+	events.Publish(r.Context(), events.Event{
+		Type:        events.ChannelUpdated,
+		AppserverID: updated.AppserverId,
+		ChannelID:   updated.ID,
+		Data:        updated,
+		Private:     &updated.IsPrivate,
+	})
+
+	render.JSON(w, r, CreateResponse(updated))
+}
+
+func channelFromProto(c *channel.Channel) types.Channel {
+	return types.Channel{
+		ID:          c.Id,
+		Name:        c.Name,
+		AppserverId: c.AppserverId,
+		IsPrivate:   c.IsPrivate,
+		UpdatedAt:   protoTime(c.UpdatedAt),
+	}
 }
@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"mistapi/src/auth"
+	"mistapi/src/permissions"
 	"mistapi/src/protos/v1/channel_role"
 	"mistapi/src/service"
 	"mistapi/src/types"
@@ -15,8 +16,11 @@ import (
 func channelRoleRouter() http.Handler {
 	r := chi.NewRouter()
 
-	r.Post("/", ChannelRoleCreateHandler)       // create a channel role
-	r.Delete("/{id}", ChannelRoleDeleteHandler) // delete a channel role
+	r.With(decodeBody[types.ChannelRoleCreate],
+		requirePermissions(bodyAppserver(func(c types.ChannelRoleCreate) string { return c.AppserverId }), permissions.ManageRoles)).
+		Post("/", ChannelRoleCreateHandler) // create a channel role
+	r.With(ValidatePathParams, requirePermissionsIfSet(permissions.QueryParam("appserver_id"), permissions.ManageRoles)).
+		Delete("/{id}", ChannelRoleDeleteHandler) // delete a channel role
 	return r
 }
 
@@ -31,25 +35,26 @@ func channelRoleRouter() http.Handler {
 // @Success      204
 // @Router       /api/v1/channel-roles [post]
 func ChannelRoleCreateHandler(w http.ResponseWriter, r *http.Request) {
-	var role types.ChannelRoleCreate
-
-	err := DecodeRequestBody(w, r, &role)
+	role, err := requestBody[types.ChannelRoleCreate](w, r)
 	if err != nil {
 		return
 	}
 
+	req := &channel_role.CreateRequest{
+		ChannelId:       role.ChannelId,
+		AppserverId:     role.AppserverId,
+		AppserverRoleId: role.AppserverRoleId,
+	}
+	if !validateRequest(w, r, req) {
+		return
+	}
+
 	authT, _ := auth.GetAuthotizationToken(r)
-	ctx, cancel := service.SetupGrpcHeaders(authT.Token)
+	ctx, cancel := service.SetupGrpcHeaders(r.Context(), authT.Token, BackendTimeout(r))
 	defer cancel()
 
 	c := service.NewGrpcClient()
-	_, err = c.GetChannelRoleClient().Create(
-		ctx, &channel_role.CreateRequest{
-			ChannelId:       role.ChannelId,
-			AppserverId:     role.AppserverId,
-			AppserverRoleId: role.AppserverRoleId,
-		},
-	)
+	_, err = c.GetChannelRoleClient().Create(ctx, req)
 
 	if err != nil {
 		HandleGrpcError(w, r, err)
@@ -65,18 +70,29 @@ func ChannelRoleCreateHandler(w http.ResponseWriter, r *http.Request) {
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
-// @Param        id   path  string  true  "Channel Role ID"
+// @Param        id            path   string  true  "Channel Role ID"
+// @Param        appserver_id  query  string  false  "Appserver of the channel role, checks the caller's permissions in the gateway"
 // @Success      204
+// @Failure      400 {object} ErrorResponse
+// @Failure      403 {object} ErrorResponse
 // @Router       /api/v1/channel-roles/{id} [delete]
 func ChannelRoleDeleteHandler(w http.ResponseWriter, r *http.Request) {
 	id := chi.URLParam(r, "id")
 
+	req := &channel_role.DeleteRequest{
+		Id:          id,
+		AppserverId: r.URL.Query().Get("appserver_id"),
+	}
+	if !validateOptionalAppserver(w, r, req, req.AppserverId) {
+		return
+	}
+
 	authT, _ := auth.GetAuthotizationToken(r)
-	ctx, cancel := service.SetupGrpcHeaders(authT.Token)
+	ctx, cancel := service.SetupGrpcHeaders(r.Context(), authT.Token, BackendTimeout(r))
 	defer cancel()
 
 	c := service.NewGrpcClient()
-	_, err := c.GetChannelRoleClient().Delete(ctx, &channel_role.DeleteRequest{Id: id})
+	_, err := c.GetChannelRoleClient().Delete(ctx, req)
 
 	if err != nil {
 		HandleGrpcError(w, r, err)
@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"mistapi/src/api"
+	"mistapi/src/problem"
 	"mistapi/src/protos/v1/channel_role"
 	"mistapi/src/testutil"
 	"mistapi/src/types"
@@ -28,9 +29,9 @@ func TestCreateChannelRole(t *testing.T) {
 	t.Run("Success:creates_channel_role", func(t *testing.T) {
 		// ARRANGE
 		input := types.ChannelRoleCreate{
-			ChannelId:       "c1",
-			AppserverId:     "s1",
-			AppserverRoleId: "r1",
+			ChannelId:       "00000000-0000-0000-0000-000000000a15",
+			AppserverId:     "00000000-0000-0000-0000-000000000a13",
+			AppserverRoleId: "00000000-0000-0000-0000-000000000a17",
 		}
 		mockReq := &channel_role.CreateRequest{
 			ChannelId:       input.ChannelId,
@@ -49,6 +50,7 @@ func TestCreateChannelRole(t *testing.T) {
 		payload := marshallPayload(t, input)
 		req, err := http.NewRequest("POST", channelRoleUrl, payload)
 		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
 		req = addContextHeaders(req)
 		rr := httptest.NewRecorder()
 
@@ -61,10 +63,11 @@ func TestCreateChannelRole(t *testing.T) {
 
 	t.Run("Error:invalid_payload", func(t *testing.T) {
 		// ARRANGE
-		expected := marshallResponse(t, api.CreateErrorResponse("Invalid attributes provided."))
+		expected := marshallResponse(t, problem.New(http.StatusUnprocessableEntity, problem.InvalidBody, "Invalid attributes provided."))
 
 		req, err := http.NewRequest("POST", channelRoleUrl, marshallPayload(t, "invalid"))
 		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
 		req = addContextHeaders(req)
 		rr := httptest.NewRecorder()
 
@@ -79,9 +82,9 @@ func TestCreateChannelRole(t *testing.T) {
 	t.Run("Error:grpc_failure", func(t *testing.T) {
 		// ARRANGE
 		input := types.ChannelRoleCreate{
-			ChannelId:       "c2",
-			AppserverId:     "s2",
-			AppserverRoleId: "r2",
+			ChannelId:       "00000000-0000-0000-0000-000000000a16",
+			AppserverId:     "00000000-0000-0000-0000-000000000a14",
+			AppserverRoleId: "00000000-0000-0000-0000-000000000a18",
 		}
 		mockReq := &channel_role.CreateRequest{
 			ChannelId:       input.ChannelId,
@@ -98,6 +101,7 @@ func TestCreateChannelRole(t *testing.T) {
 
 		req, err := http.NewRequest("POST", channelRoleUrl, marshallPayload(t, input))
 		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
 		req = addContextHeaders(req)
 		rr := httptest.NewRecorder()
 
@@ -112,13 +116,14 @@ func TestCreateChannelRole(t *testing.T) {
 func TestDeleteChannelRole(t *testing.T) {
 	log.SetOutput(new(strings.Builder))
 
+	appserverId := "00000000-0000-0000-0000-000000000002"
 	r := chi.NewRouter()
 	r.Delete("/{id}", api.ChannelRoleDeleteHandler)
 
 	t.Run("Success:deletes_channel_role", func(t *testing.T) {
 		// ARRANGE
-		id := "1"
-		mockReq := &channel_role.DeleteRequest{Id: id}
+		id := "00000000-0000-0000-0000-000000000001"
+		mockReq := &channel_role.DeleteRequest{Id: id, AppserverId: appserverId}
 		mockResp := &channel_role.DeleteResponse{}
 
 		mockService := new(testutil.MockChannelRoleService)
@@ -128,7 +133,7 @@ func TestDeleteChannelRole(t *testing.T) {
 		mockClient.On("GetChannelRoleClient").Return(mockService)
 		testutil.MockGrpcClient(t, mockClient)
 
-		req, err := http.NewRequest("DELETE", fmt.Sprintf("/%s", id), nil)
+		req, err := http.NewRequest("DELETE", fmt.Sprintf("/%s?appserver_id=%s", id, appserverId), nil)
 		require.NoError(t, err)
 		req = addContextHeaders(req)
 		req = withURLParam(req, "id", id)
@@ -143,16 +148,16 @@ func TestDeleteChannelRole(t *testing.T) {
 
 	t.Run("Error:grpc_failure", func(t *testing.T) {
 		// ARRANGE
-		id := "fail"
+		id := "00000000-0000-0000-0000-000000000a12"
 		mockService := new(testutil.MockChannelRoleService)
-		mockService.On("Delete", mock.Anything, &channel_role.DeleteRequest{Id: id}).
+		mockService.On("Delete", mock.Anything, &channel_role.DeleteRequest{Id: id, AppserverId: appserverId}).
 			Return(nil, errors.New("boom"))
 
 		mockClient := new(testutil.MockClient)
 		mockClient.On("GetChannelRoleClient").Return(mockService)
 		testutil.MockGrpcClient(t, mockClient)
 
-		req, err := http.NewRequest("DELETE", fmt.Sprintf("/%s", id), nil)
+		req, err := http.NewRequest("DELETE", fmt.Sprintf("/%s?appserver_id=%s", id, appserverId), nil)
 		require.NoError(t, err)
 		req = addContextHeaders(req)
 		req = withURLParam(req, "id", id)
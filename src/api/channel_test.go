@@ -9,13 +9,16 @@ import (
 	"testing"
 
 	"mistapi/src/api"
+	"mistapi/src/problem"
 	"mistapi/src/protos/v1/channel"
 	"mistapi/src/testutil"
 	"mistapi/src/types"
 
+	"github.com/go-chi/chi/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 var (
@@ -28,9 +31,9 @@ func TestCreateChannel(t *testing.T) {
 	t.Run("Success:successfully_creating_channel", func(t *testing.T) {
 		// ARRANGE
 		c := types.Channel{
-			ID:          "1",
+			ID:          "00000000-0000-0000-0000-000000000001",
 			Name:        "foo-channel",
-			AppserverId: "1",
+			AppserverId: "00000000-0000-0000-0000-000000000001",
 		}
 		expected := marshallResponse(t, api.CreateResponse(c))
 		mockCreateRequest := &channel.CreateRequest{Name: c.Name, AppserverId: c.AppserverId}
@@ -50,6 +53,7 @@ func TestCreateChannel(t *testing.T) {
 		payload := marshallPayload(t, types.ChannelCreate{Name: c.Name, AppserverId: c.AppserverId})
 		req, err := http.NewRequest("POST", apiUrl, payload)
 		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
 		req = addContextHeaders(req)
 		rr := httptest.NewRecorder()
 
@@ -63,9 +67,9 @@ func TestCreateChannel(t *testing.T) {
 
 	t.Run("Error:errors_during_creation_returns_error_status", func(t *testing.T) {
 		// ARRANGE
-		expected := marshallResponse(t, api.CreateErrorResponse("Internal Server Error."))
+		expected := marshallResponse(t, problem.New(http.StatusInternalServerError, problem.Internal, "Internal Server Error."))
 		mockService := new(testutil.MockChannelService)
-		mockCreateRequest := &channel.CreateRequest{Name: "foo-channel", AppserverId: "1"}
+		mockCreateRequest := &channel.CreateRequest{Name: "foo-channel", AppserverId: "00000000-0000-0000-0000-000000000001"}
 		mockResponse := &channel.CreateResponse{}
 		mockService.On("Create", mock.Anything, mockCreateRequest).Return(mockResponse, errors.New("boom"))
 
@@ -74,9 +78,10 @@ func TestCreateChannel(t *testing.T) {
 		testutil.MockGrpcClient(t, mockClient)
 
 		// Prepare the HTTP request
-		payload := marshallPayload(t, types.ChannelCreate{Name: "foo-channel", AppserverId: "1"})
+		payload := marshallPayload(t, types.ChannelCreate{Name: "foo-channel", AppserverId: "00000000-0000-0000-0000-000000000001"})
 		req, err := http.NewRequest("POST", apiUrl, payload)
 		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
 		req = addContextHeaders(req)
 		rr := httptest.NewRecorder()
 
@@ -90,9 +95,9 @@ func TestCreateChannel(t *testing.T) {
 
 	t.Run("Error:errors_with_invalid_post_parameters", func(t *testing.T) {
 		// ARRANGE
-		expected := marshallResponse(t, api.CreateErrorResponse("Invalid attributes provided."))
+		expected := marshallResponse(t, problem.New(http.StatusUnprocessableEntity, problem.InvalidBody, "Invalid attributes provided."))
 		mockService := new(testutil.MockChannelService)
-		mockCreateRequest := &channel.CreateRequest{Name: "foo-channel", AppserverId: "1"}
+		mockCreateRequest := &channel.CreateRequest{Name: "foo-channel", AppserverId: "00000000-0000-0000-0000-000000000001"}
 		mockResponse := &channel.CreateResponse{}
 		mockService.On("Create", mock.Anything, mockCreateRequest).Return(mockResponse, nil)
 
@@ -104,6 +109,7 @@ func TestCreateChannel(t *testing.T) {
 		payload := marshallPayload(t, "invalid")
 		req, err := http.NewRequest("POST", apiUrl, payload)
 		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
 		req = addContextHeaders(req)
 		rr := httptest.NewRecorder()
 
@@ -115,3 +121,88 @@ func TestCreateChannel(t *testing.T) {
 		assert.JSONEq(t, expected, rr.Body.String())
 	})
 }
+
+func TestUpdateChannel(t *testing.T) {
+	log.SetOutput(new(strings.Builder))
+
+	r := chi.NewRouter()
+	r.Patch("/{id}", api.ChannelUpdateHandler)
+
+	t.Run("Success:null_resets_is_private", func(t *testing.T) {
+		// ARRANGE
+		expected := marshallResponse(t, api.CreateResponse(types.Channel{
+			ID: "00000000-0000-0000-0000-000000000001", Name: "general", AppserverId: "00000000-0000-0000-0000-000000000002",
+		}))
+		mockRequest := &channel.UpdateRequest{
+			Channel:    &channel.Channel{Id: "00000000-0000-0000-0000-000000000001", AppserverId: "00000000-0000-0000-0000-000000000002"},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"is_private"}},
+		}
+		mockResponse := &channel.UpdateResponse{Channel: &channel.Channel{
+			Id: "00000000-0000-0000-0000-000000000001", Name: "general", AppserverId: "00000000-0000-0000-0000-000000000002",
+		}}
+		mockService := new(testutil.MockChannelService)
+		mockService.On("Update", mock.Anything, mockRequest).Return(mockResponse, nil)
+
+		mockClient := new(testutil.MockClient)
+		mockClient.On("GetChannelClient").Return(mockService)
+		testutil.MockGrpcClient(t, mockClient)
+
+		req := mergePatchRequest(t, "/00000000-0000-0000-0000-000000000001", `{"appserver_id":"00000000-0000-0000-0000-000000000002","is_private":null}`)
+		rr := httptest.NewRecorder()
+
+		// ACT
+		r.ServeHTTP(rr, req)
+
+		// ASSERT
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, expected, rr.Body.String())
+	})
+
+	t.Run("Error:missing_appserver_id_is_reported", func(t *testing.T) {
+		// ARRANGE
+		expected := problem.New(http.StatusBadRequest, problem.ValidationFailed, "Invalid patch.")
+		expected.Errors = []api.FieldError{{Field: "appserver_id", Message: "is required"}}
+		mockClient := new(testutil.MockClient)
+		testutil.MockGrpcClient(t, mockClient)
+
+		req := mergePatchRequest(t, "/00000000-0000-0000-0000-000000000001", `{"name":"general"}`)
+		rr := httptest.NewRecorder()
+
+		// ACT
+		r.ServeHTTP(rr, req)
+
+		// ASSERT
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.JSONEq(t, marshallResponse(t, expected), rr.Body.String())
+	})
+
+	names := []struct {
+		name    string
+		value   string
+		message string
+	}{
+		{"Error:empty_name_is_rejected", "", "value length must be at least 1 characters"},
+		{"Error:name_over_64_characters_is_rejected", strings.Repeat("a", 65), "value length must be at most 64 characters"},
+	}
+
+	for _, tt := range names {
+		t.Run(tt.name, func(t *testing.T) {
+			// ARRANGE
+			expected := problem.New(http.StatusBadRequest, problem.ValidationFailed, "Invalid patch.")
+			expected.Errors = []api.FieldError{{Field: "name", Message: tt.message}}
+			mockClient := new(testutil.MockClient)
+			testutil.MockGrpcClient(t, mockClient)
+
+			req := mergePatchRequest(t, "/00000000-0000-0000-0000-000000000001", `{"appserver_id":"00000000-0000-0000-0000-000000000002","name":"`+tt.value+`"}`)
+			rr := httptest.NewRecorder()
+
+			// ACT
+			r.ServeHTTP(rr, req)
+
+			// ASSERT
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+			assert.JSONEq(t, marshallResponse(t, expected), rr.Body.String())
+			mockClient.AssertNotCalled(t, "GetChannelClient")
+		})
+	}
+}
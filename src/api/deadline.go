@@ -0,0 +1,90 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"mistapi/src/config"
+	"mistapi/src/problem"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// RequestTimeoutHeader lets clients ask for a shorter or longer budget for
+// the backend calls of a request. It accepts a Go duration ("1500ms", "10s")
+// or a number of seconds and is capped by the server's max timeout.
+const RequestTimeoutHeader = "X-Request-Timeout"
+
+// MinRequestTimeout is the shortest budget accepted in X-Request-Timeout.
+const MinRequestTimeout = 100 * time.Millisecond
+
+type deadlineContextKey struct{}
+
+type deadlinePolicy struct {
+	cfg       config.BackendConfig
+	requested time.Duration
+}
+
+// DeadlineMiddleware validates the X-Request-Timeout header and makes the
+// timeout policy available to BackendTimeout.
+func DeadlineMiddleware(cfg config.BackendConfig) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			policy := &deadlinePolicy{cfg: cfg}
+
+			if h := r.Header.Get(RequestTimeoutHeader); h != "" {
+				requested, err := parseRequestTimeout(h)
+				if err != nil {
+					RenderError(w, r, http.StatusBadRequest, problem.BadRequest, fmt.Sprintf("Invalid %s header.", RequestTimeoutHeader))
+					return
+				}
+				policy.requested = requested
+			}
+
+			ctx := context.WithValue(r.Context(), deadlineContextKey{}, policy)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// BackendTimeout returns the budget for the backend calls made while serving
+// r: the client's X-Request-Timeout capped by the max timeout if given,
+// otherwise the timeout configured for the matched route or the default one.
+func BackendTimeout(r *http.Request) time.Duration {
+	policy, ok := r.Context().Value(deadlineContextKey{}).(*deadlinePolicy)
+	if !ok {
+		return config.DefaultBackendTimeout
+	}
+
+	if policy.requested > 0 {
+		return min(policy.requested, policy.cfg.MaxTimeout)
+	}
+
+	route := fmt.Sprintf("%s %s", r.Method, chi.RouteContext(r.Context()).RoutePattern())
+	if timeout, ok := policy.cfg.RouteTimeouts[route]; ok {
+		return timeout
+	}
+
+	if policy.cfg.Timeout > 0 {
+		return policy.cfg.Timeout
+	}
+	return config.DefaultBackendTimeout
+}
+
+func parseRequestTimeout(value string) (time.Duration, error) {
+	var timeout time.Duration
+
+	if seconds, err := strconv.ParseFloat(value, 64); err == nil {
+		timeout = time.Duration(seconds * float64(time.Second))
+	} else if timeout, err = time.ParseDuration(value); err != nil {
+		return 0, err
+	}
+
+	if timeout < MinRequestTimeout {
+		return 0, fmt.Errorf("timeout must be at least %s", MinRequestTimeout)
+	}
+	return timeout, nil
+}
@@ -0,0 +1,83 @@
+package api_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"mistapi/src/api"
+	"mistapi/src/config"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeadlineMiddleware(t *testing.T) {
+	cfg := config.BackendConfig{
+		Timeout:    5 * time.Second,
+		MaxTimeout: 30 * time.Second,
+		RouteTimeouts: map[string]time.Duration{
+			"GET /api/v1/appservers/{id}": 10 * time.Second,
+		},
+	}
+
+	// mirrors how SetupRouter mounts the api routers
+	setupRouter := func(got *time.Duration) http.Handler {
+		capture := func(w http.ResponseWriter, r *http.Request) {
+			*got = api.BackendTimeout(r)
+		}
+		r := chi.NewRouter()
+		r.Route("/api/", func(r chi.Router) {
+			r.Use(api.DeadlineMiddleware(cfg))
+
+			sub := chi.NewRouter()
+			sub.Get("/{id}", capture)
+			sub.Delete("/{id}", capture)
+			r.Mount("/v1/appservers", sub)
+		})
+		return r
+	}
+
+	tests := []struct {
+		name           string
+		method         string
+		header         string
+		expectedStatus int
+		expected       time.Duration
+	}{
+		{"Success:route_timeout_is_used", http.MethodGet, "", http.StatusOK, 10 * time.Second},
+		{"Success:default_timeout_is_used", http.MethodDelete, "", http.StatusOK, 5 * time.Second},
+		{"Success:requested_duration_is_used", http.MethodDelete, "1500ms", http.StatusOK, 1500 * time.Millisecond},
+		{"Success:requested_seconds_are_used", http.MethodGet, "20", http.StatusOK, 20 * time.Second},
+		{"Success:requested_timeout_is_capped", http.MethodGet, "2m", http.StatusOK, 30 * time.Second},
+		{"Error:invalid_header_is_rejected", http.MethodGet, "soon", http.StatusBadRequest, 0},
+		{"Error:negative_header_is_rejected", http.MethodGet, "-1", http.StatusBadRequest, 0},
+		{"Error:header_below_the_minimum_is_rejected", http.MethodGet, "1ms", http.StatusBadRequest, 0},
+		{"Success:minimum_header_is_used", http.MethodGet, "100ms", http.StatusOK, 100 * time.Millisecond},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// ARRANGE
+			var got time.Duration
+			req := httptest.NewRequest(tt.method, "/api/v1/appservers/123", nil)
+			if tt.header != "" {
+				req.Header.Set(api.RequestTimeoutHeader, tt.header)
+			}
+			rr := httptest.NewRecorder()
+
+			// ACT
+			setupRouter(&got).ServeHTTP(rr, req)
+
+			// ASSERT
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+
+	t.Run("Success:default_timeout_without_middleware", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		assert.Equal(t, config.DefaultBackendTimeout, api.BackendTimeout(req))
+	})
+}
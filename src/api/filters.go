@@ -0,0 +1,78 @@
+package api
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const maxFilterLength = 100
+
+// listFilters reads the filter query parameters of a list. Handlers take the
+// filters they support and then call done so invalid values are rejected.
+type listFilters struct {
+	query url.Values
+	// applied holds the filters in use, cursors are bound to them
+	applied url.Values
+	errs    []FieldError
+}
+
+func newListFilters(r *http.Request) *listFilters {
+	return &listFilters{query: r.URL.Query(), applied: url.Values{}}
+}
+
+// text returns the trimmed value of name, nil when it is absent or blank.
+func (f *listFilters) text(name string) *wrapperspb.StringValue {
+	value := strings.TrimSpace(f.query.Get(name))
+	if value == "" {
+		return nil
+	}
+	if utf8.RuneCountInString(value) > maxFilterLength {
+		f.errs = append(f.errs, FieldError{
+			Field: name, Message: "must be at most " + strconv.Itoa(maxFilterLength) + " characters",
+		})
+		return nil
+	}
+
+	f.applied.Set(name, value)
+	return wrapperspb.String(value)
+}
+
+// boolean returns the value of name, nil when it is absent.
+func (f *listFilters) boolean(name string) *wrapperspb.BoolValue {
+	raw := f.query.Get(name)
+	if raw == "" {
+		return nil
+	}
+	value, err := strconv.ParseBool(raw)
+	if err != nil {
+		f.errs = append(f.errs, FieldError{Field: name, Message: "must be true or false"})
+		return nil
+	}
+
+	f.applied.Set(name, strconv.FormatBool(value))
+	return wrapperspb.Bool(value)
+}
+
+// done renders the error response and returns false when a filter is
+// invalid.
+func (f *listFilters) done(w http.ResponseWriter, r *http.Request) bool {
+	if len(f.errs) > 0 {
+		RenderFieldErrors(w, r, "Invalid filters.", f.errs)
+		return false
+	}
+	return true
+}
+
+// list names the filtered list, so a cursor cannot be reused with other
+// filters.
+func (f *listFilters) list(base string) string {
+	if len(f.applied) == 0 {
+		return base
+	}
+	return base + "?" + f.applied.Encode()
+}
@@ -0,0 +1,146 @@
+package api_test
+
+import (
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"mistapi/src/api"
+	"mistapi/src/protos/v1/appserver_role_sub"
+	"mistapi/src/protos/v1/appserver_sub"
+	"mistapi/src/protos/v1/channel"
+	"mistapi/src/testutil"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestListFilters(t *testing.T) {
+	log.SetOutput(new(strings.Builder))
+
+	sId := "00000000-0000-0000-0000-000000000123"
+	cursors := api.NewCursors("secret")
+	r := chi.NewRouter()
+	r.Use(api.CursorMiddleware(cursors))
+	r.Get("/", api.AppserverListHandler)
+	r.Get("/{id}/channels", api.AppserverListChannelsHandler)
+	r.Get("/{id}/subs", api.AppserverListSubsHandler)
+	r.Get("/{id}/role-subs", api.AppserverListRoleSubHandler)
+
+	get := func(t *testing.T, url string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest(http.MethodGet, url, nil)
+		require.NoError(t, err)
+		rr := httptest.NewRecorder()
+		r.ServeHTTP(rr, addContextHeaders(req))
+		return rr
+	}
+
+	t.Run("Success:channel_filters_are_sent_to_the_backend", func(t *testing.T) {
+		// ARRANGE
+		mockService := new(testutil.MockChannelService)
+		mockService.On("ListServerChannels", mock.Anything, &channel.ListServerChannelsRequest{
+			AppserverId: sId,
+			PageSize:    50,
+			Name:        wrapperspb.String("gen"),
+			IsPrivate:   wrapperspb.Bool(false),
+		}).Return(&channel.ListServerChannelsResponse{}, nil)
+		mockClient := new(testutil.MockClient)
+		mockClient.On("GetChannelClient").Return(mockService)
+		testutil.MockGrpcClient(t, mockClient)
+
+		// ACT
+		rr := get(t, "/"+sId+"/channels?name=+gen+&is_private=false")
+
+		// ASSERT
+		assert.Equal(t, http.StatusOK, rr.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("Success:appserver_name_filter", func(t *testing.T) {
+		// ARRANGE
+		mockService := new(testutil.MockAppserverSubService)
+		mockService.On("ListUserServerSubs", mock.Anything, &appserver_sub.ListUserServerSubsRequest{
+			PageSize: 50, Name: wrapperspb.String("mist"),
+		}).Return(&appserver_sub.ListUserServerSubsResponse{}, nil)
+		mockClient := new(testutil.MockClient)
+		mockClient.On("GetAppserverSubClient").Return(mockService)
+		testutil.MockGrpcClient(t, mockClient)
+
+		// ACT
+		rr := get(t, "/?name=mist")
+
+		// ASSERT
+		assert.Equal(t, http.StatusOK, rr.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("Success:member_search", func(t *testing.T) {
+		// ARRANGE
+		mockService := new(testutil.MockAppserverSubService)
+		mockService.On("ListAppserverUserSubs", mock.Anything, &appserver_sub.ListAppserverUserSubsRequest{
+			AppserverId: sId, PageSize: 50, Q: wrapperspb.String("ali"),
+		}).Return(&appserver_sub.ListAppserverUserSubsResponse{}, nil)
+		mockClient := new(testutil.MockClient)
+		mockClient.On("GetAppserverSubClient").Return(mockService)
+		testutil.MockGrpcClient(t, mockClient)
+
+		// ACT
+		rr := get(t, "/"+sId+"/subs?q=ali")
+
+		// ASSERT
+		assert.Equal(t, http.StatusOK, rr.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("Success:role_sub_role_filter", func(t *testing.T) {
+		// ARRANGE
+		mockService := new(testutil.MockAppserverRoleSubService)
+		mockService.On("ListServerRoleSubs", mock.Anything, &appserver_role_sub.ListServerRoleSubsRequest{
+			AppserverId: sId, PageSize: 50, AppserverRoleId: wrapperspb.String("00000000-0000-0000-0000-000000000a0a"),
+		}).Return(&appserver_role_sub.ListServerRoleSubsResponse{}, nil)
+		mockClient := new(testutil.MockClient)
+		mockClient.On("GetAppserverRoleSubClient").Return(mockService)
+		testutil.MockGrpcClient(t, mockClient)
+
+		// ACT
+		rr := get(t, "/"+sId+"/role-subs?role_id=00000000-0000-0000-0000-000000000a0a")
+
+		// ASSERT
+		assert.Equal(t, http.StatusOK, rr.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("Error:invalid_filters", func(t *testing.T) {
+		// ARRANGE
+		testutil.MockGrpcClient(t, new(testutil.MockClient))
+
+		// ACT
+		rr := get(t, "/"+sId+"/channels?is_private=maybe&name="+strings.Repeat("a", 101))
+
+		// ASSERT
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.JSONEq(t, `{"type":"urn:mist:problem:validation_failed","title":"Validation failed","status":400,
+			"code":"validation_failed","detail":"Invalid filters.","errors":[
+			{"field":"name","message":"must be at most 100 characters"},
+			{"field":"is_private","message":"must be true or false"}
+		]}`, rr.Body.String())
+	})
+
+	t.Run("Error:cursor_of_other_filters", func(t *testing.T) {
+		// ARRANGE
+		testutil.MockGrpcClient(t, new(testutil.MockClient))
+		cursor := cursors.Encode("appservers/"+sId+"/channels?name=a", "page-2")
+
+		// ACT
+		rr := get(t, "/"+sId+"/channels?name=b&cursor="+cursor)
+
+		// ASSERT
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"field":"cursor"`)
+	})
+}
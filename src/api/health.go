@@ -0,0 +1,103 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"mistapi/src/logging"
+
+	"github.com/go-chi/render"
+)
+
+// HealthCheck checks a dependency. The returned detail (e.g. a connection
+// state) is reported whether the check fails or not.
+type HealthCheck func(ctx context.Context) (detail string, err error)
+
+type DependencyHealth struct {
+	Status string `json:"status"`
+	Detail string `json:"detail,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+type HealthReport struct {
+	Status       string                      `json:"status"`
+	Dependencies map[string]DependencyHealth `json:"dependencies,omitempty"`
+}
+
+// Readiness serves /health/ready. Results are cached for a short while so
+// frequent probes don't hammer the dependencies, and the endpoint reports
+// not-ready as soon as the service starts draining.
+type Readiness struct {
+	checks   map[string]HealthCheck
+	cacheTTL time.Duration
+	timeout  time.Duration
+	draining atomic.Bool
+
+	mu        sync.Mutex
+	report    HealthReport
+	checkedAt time.Time
+}
+
+func NewReadiness(cacheTTL, timeout time.Duration, checks map[string]HealthCheck) *Readiness {
+	return &Readiness{checks: checks, cacheTTL: cacheTTL, timeout: timeout}
+}
+
+// SetDraining makes every following readiness check fail.
+func (rd *Readiness) SetDraining() {
+	rd.draining.Store(true)
+}
+
+// Check returns the readiness report, running the dependency checks only when
+// the cached one is stale.
+func (rd *Readiness) Check(ctx context.Context) HealthReport {
+	if rd.draining.Load() {
+		return HealthReport{Status: "draining"}
+	}
+
+	rd.mu.Lock()
+	defer rd.mu.Unlock()
+
+	if !rd.checkedAt.IsZero() && time.Since(rd.checkedAt) < rd.cacheTTL {
+		return rd.report
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, rd.timeout)
+	defer cancel()
+
+	report := HealthReport{Status: "ready", Dependencies: make(map[string]DependencyHealth, len(rd.checks))}
+	for name, check := range rd.checks {
+		detail, err := check(ctx)
+		dep := DependencyHealth{Status: "ok", Detail: detail}
+		if err != nil {
+			dep.Status = "error"
+			dep.Error = err.Error()
+			report.Status = "not_ready"
+			logging.FromContext(ctx).Warn("Readiness check failed", "dependency", name, "error", err)
+		}
+		report.Dependencies[name] = dep
+	}
+
+	rd.report = report
+	rd.checkedAt = time.Now()
+	return report
+}
+
+// Handler godoc
+// @Summary      Readiness probe
+// @Description  Reports whether the gateway and its dependencies can serve traffic
+// @Tags         health
+// @Produce      json
+// @Success      200 {object} HealthReport
+// @Failure      503 {object} HealthReport
+// @Router       /health/ready [get]
+func (rd *Readiness) Handler(w http.ResponseWriter, r *http.Request) {
+	report := rd.Check(r.Context())
+
+	if report.Status != "ready" {
+		render.Status(r, http.StatusServiceUnavailable)
+	}
+	render.JSON(w, r, report)
+}
@@ -0,0 +1,94 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"mistapi/src/api"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadiness(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success:ready_when_all_dependencies_are_ok", func(t *testing.T) {
+		// ARRANGE
+		readiness := api.NewReadiness(0, time.Second, map[string]api.HealthCheck{
+			"backend": func(ctx context.Context) (string, error) { return "READY", nil },
+		})
+		rr := httptest.NewRecorder()
+
+		// ACT
+		readiness.Handler(rr, httptest.NewRequest(http.MethodGet, "/health/ready", nil))
+
+		// ASSERT
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t,
+			`{"status": "ready", "dependencies": {"backend": {"status": "ok", "detail": "READY"}}}`,
+			rr.Body.String())
+	})
+
+	t.Run("Error:not_ready_when_a_dependency_fails", func(t *testing.T) {
+		// ARRANGE
+		readiness := api.NewReadiness(0, time.Second, map[string]api.HealthCheck{
+			"backend": func(ctx context.Context) (string, error) {
+				return "TRANSIENT_FAILURE", errors.New("backend connection is TRANSIENT_FAILURE")
+			},
+		})
+		rr := httptest.NewRecorder()
+
+		// ACT
+		readiness.Handler(rr, httptest.NewRequest(http.MethodGet, "/health/ready", nil))
+
+		// ASSERT
+		assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+		assert.JSONEq(t, `{
+			"status": "not_ready",
+			"dependencies": {"backend": {
+				"status": "error",
+				"detail": "TRANSIENT_FAILURE",
+				"error": "backend connection is TRANSIENT_FAILURE"
+			}}
+		}`, rr.Body.String())
+	})
+
+	t.Run("Success:results_are_cached", func(t *testing.T) {
+		// ARRANGE
+		calls := 0
+		readiness := api.NewReadiness(time.Minute, time.Second, map[string]api.HealthCheck{
+			"backend": func(ctx context.Context) (string, error) {
+				calls++
+				return "READY", nil
+			},
+		})
+
+		// ACT
+		readiness.Check(context.Background())
+		readiness.Check(context.Background())
+
+		// ASSERT
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("Error:not_ready_while_draining", func(t *testing.T) {
+		// ARRANGE
+		readiness := api.NewReadiness(time.Minute, time.Second, map[string]api.HealthCheck{
+			"backend": func(ctx context.Context) (string, error) { return "READY", nil },
+		})
+		readiness.Check(context.Background())
+		readiness.SetDraining()
+		rr := httptest.NewRecorder()
+
+		// ACT
+		readiness.Handler(rr, httptest.NewRequest(http.MethodGet, "/health/ready", nil))
+
+		// ASSERT
+		assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+		assert.JSONEq(t, `{"status": "draining"}`, rr.Body.String())
+	})
+}
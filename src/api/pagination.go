@@ -0,0 +1,153 @@
+package api
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+const (
+	defaultPageLimit = 50
+	maxPageLimit     = 100
+	cursorMACSize    = 16
+)
+
+// PageMeta is the DataResponse.Meta of paginated lists.
+type PageMeta struct {
+	// NextCursor fetches the next page when passed as cursor, empty on the
+	// last page.
+	NextCursor string `json:"next_cursor,omitempty"`
+	HasMore    bool   `json:"has_more"`
+}
+
+// Cursors turns the page tokens of the backend into opaque cursors signed for
+// the list they were issued for, so clients can neither forge them nor reuse
+// them on another list.
+type Cursors struct {
+	key []byte
+}
+
+// NewCursors signs cursors with secret, or with a random key when it is
+// empty.
+func NewCursors(secret string) *Cursors {
+	if secret != "" {
+		return &Cursors{key: []byte(secret)}
+	}
+
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		panic(fmt.Sprintf("generating cursor key: %v", err))
+	}
+	return &Cursors{key: key}
+}
+
+func (c *Cursors) mac(list, token string) []byte {
+	h := hmac.New(sha256.New, c.key)
+	h.Write([]byte(list))
+	h.Write([]byte{0})
+	h.Write([]byte(token))
+	return h.Sum(nil)[:cursorMACSize]
+}
+
+// Encode returns the cursor of token for list, empty when token is.
+func (c *Cursors) Encode(list, token string) string {
+	if token == "" {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(append(c.mac(list, token), token...))
+}
+
+// Decode returns the page token of cursor, false when it was not issued for
+// list.
+func (c *Cursors) Decode(list, cursor string) (string, bool) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil || len(raw) <= cursorMACSize {
+		return "", false
+	}
+
+	token := string(raw[cursorMACSize:])
+	if !hmac.Equal(raw[:cursorMACSize], c.mac(list, token)) {
+		return "", false
+	}
+	return token, true
+}
+
+type cursorsContextKey struct{}
+
+// fallbackCursors serves handlers used without CursorMiddleware.
+var fallbackCursors = NewCursors("")
+
+// CursorMiddleware makes c available to the paginated handlers behind it.
+func CursorMiddleware(c *Cursors) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), cursorsContextKey{}, c)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+func cursorsFromContext(ctx context.Context) *Cursors {
+	if c, ok := ctx.Value(cursorsContextKey{}).(*Cursors); ok {
+		return c
+	}
+	return fallbackCursors
+}
+
+// page is the page of a list requested by the limit and cursor query
+// parameters.
+type page struct {
+	// list identifies the list, cursors are only valid for it
+	list  string
+	size  int32
+	token string
+}
+
+// parsePage reads the limit and cursor query parameters of list. It renders
+// the error response and returns false when they are invalid.
+func parsePage(w http.ResponseWriter, r *http.Request, list string) (page, bool) {
+	p := page{list: list, size: defaultPageLimit}
+	query := r.URL.Query()
+
+	var errs []FieldError
+	if limit := query.Get("limit"); limit != "" {
+		size, err := strconv.Atoi(limit)
+		if err != nil || size < 1 || size > maxPageLimit {
+			errs = append(errs, FieldError{
+				Field: "limit", Message: "must be between 1 and " + strconv.Itoa(maxPageLimit),
+			})
+		}
+		p.size = int32(size)
+	}
+
+	if cursor := query.Get("cursor"); cursor != "" {
+		token, ok := cursorsFromContext(r.Context()).Decode(list, cursor)
+		if !ok {
+			errs = append(errs, FieldError{Field: "cursor", Message: "is invalid"})
+		}
+		p.token = token
+	}
+
+	if len(errs) > 0 {
+		RenderFieldErrors(w, r, "Invalid pagination.", errs)
+		return page{}, false
+	}
+	return p, true
+}
+
+// CreatePageResponse wraps a page of a list with the cursor of the next page,
+// built from the page token the backend returned.
+func CreatePageResponse(r *http.Request, p page, data interface{}, nextToken string) *DataResponse {
+	return &DataResponse{
+		Meta: PageMeta{
+			NextCursor: cursorsFromContext(r.Context()).Encode(p.list, nextToken),
+			HasMore:    nextToken != "",
+		},
+		Data: data,
+	}
+}
@@ -0,0 +1,144 @@
+package api_test
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"mistapi/src/api"
+	"mistapi/src/protos/v1/channel"
+	"mistapi/src/testutil"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursors(t *testing.T) {
+	cursors := api.NewCursors("secret")
+
+	t.Run("Success:round_trips_the_page_token", func(t *testing.T) {
+		// ACT
+		cursor := cursors.Encode("channels", "token")
+		token, ok := cursors.Decode("channels", cursor)
+
+		// ASSERT
+		assert.True(t, ok)
+		assert.Equal(t, "token", token)
+		assert.NotContains(t, cursor, "token")
+	})
+
+	t.Run("Success:last_page_has_no_cursor", func(t *testing.T) {
+		// ACT & ASSERT
+		assert.Empty(t, cursors.Encode("channels", ""))
+	})
+
+	t.Run("Error:cursor_of_another_list", func(t *testing.T) {
+		// ACT
+		_, ok := cursors.Decode("roles", cursors.Encode("channels", "token"))
+
+		// ASSERT
+		assert.False(t, ok)
+	})
+
+	t.Run("Error:cursor_signed_with_another_secret", func(t *testing.T) {
+		// ACT
+		_, ok := cursors.Decode("channels", api.NewCursors("other").Encode("channels", "token"))
+
+		// ASSERT
+		assert.False(t, ok)
+	})
+
+	t.Run("Error:malformed_cursor", func(t *testing.T) {
+		// ACT
+		_, ok := cursors.Decode("channels", "not a cursor")
+
+		// ASSERT
+		assert.False(t, ok)
+	})
+}
+
+func TestPagination(t *testing.T) {
+	log.SetOutput(new(strings.Builder))
+
+	sId := "00000000-0000-0000-0000-000000000123"
+	cursors := api.NewCursors("secret")
+	r := chi.NewRouter()
+	r.Use(api.CursorMiddleware(cursors))
+	r.Get("/{id}/channels", api.AppserverListChannelsHandler)
+
+	list := func(t *testing.T, query string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest(http.MethodGet, "/"+sId+"/channels"+query, nil)
+		require.NoError(t, err)
+		rr := httptest.NewRecorder()
+		r.ServeHTTP(rr, addContextHeaders(req))
+		return rr
+	}
+
+	t.Run("Success:follows_the_next_cursor", func(t *testing.T) {
+		// ARRANGE
+		mockService := new(testutil.MockChannelService)
+		mockService.On("ListServerChannels", mock.Anything, &channel.ListServerChannelsRequest{
+			AppserverId: sId, PageSize: 1,
+		}).Return(&channel.ListServerChannelsResponse{
+			Channels:      []*channel.Channel{{Id: "00000000-0000-0000-0000-000000000001", AppserverId: sId}},
+			NextPageToken: "page-2",
+		}, nil)
+		mockService.On("ListServerChannels", mock.Anything, &channel.ListServerChannelsRequest{
+			AppserverId: sId, PageSize: 1, PageToken: "page-2",
+		}).Return(&channel.ListServerChannelsResponse{
+			Channels: []*channel.Channel{{Id: "00000000-0000-0000-0000-000000000002", AppserverId: sId}},
+		}, nil)
+
+		mockClient := new(testutil.MockClient)
+		mockClient.On("GetChannelClient").Return(mockService)
+		testutil.MockGrpcClient(t, mockClient)
+
+		// ACT
+		first := list(t, "?limit=1")
+		var res struct{ Meta api.PageMeta }
+		require.NoError(t, json.Unmarshal(first.Body.Bytes(), &res))
+		second := list(t, "?limit=1&cursor="+res.Meta.NextCursor)
+
+		// ASSERT
+		assert.Equal(t, http.StatusOK, first.Code)
+		assert.True(t, res.Meta.HasMore)
+		assert.Equal(t, cursors.Encode("appservers/"+sId+"/channels", "page-2"), res.Meta.NextCursor)
+		assert.Equal(t, http.StatusOK, second.Code)
+		assert.Contains(t, second.Body.String(), `"meta":{"has_more":false}`)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("Error:invalid_limit", func(t *testing.T) {
+		// ARRANGE
+		testutil.MockGrpcClient(t, new(testutil.MockClient))
+
+		// ACT
+		rr := list(t, "?limit=101")
+
+		// ASSERT
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.JSONEq(t,
+			`{"type":"urn:mist:problem:validation_failed","title":"Validation failed","status":400,
+				"code":"validation_failed","detail":"Invalid pagination.",
+				"errors":[{"field":"limit","message":"must be between 1 and 100"}]}`,
+			rr.Body.String())
+	})
+
+	t.Run("Error:tampered_cursor", func(t *testing.T) {
+		// ARRANGE
+		testutil.MockGrpcClient(t, new(testutil.MockClient))
+		cursor := cursors.Encode("appservers/other/channels", "page-2")
+
+		// ACT
+		rr := list(t, "?cursor="+cursor)
+
+		// ASSERT
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"field":"cursor"`)
+	})
+}
@@ -0,0 +1,172 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"time"
+	"unicode/utf8"
+
+	"mistapi/src/problem"
+
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const mergePatchContentType = "application/merge-patch+json"
+
+// maxNameLength is the max_len rule of the names in the create requests.
+const maxNameLength = 64
+
+// mergePatch is a JSON merge patch (RFC 7396) request body. Handlers take the
+// fields they support, which records the update mask, and then call done so
+// anything left over is rejected.
+type mergePatch struct {
+	fields map[string]json.RawMessage
+	paths  []string
+	errs   []FieldError
+}
+
+// decodePatch decodes the merge patch ahead of the handler, like decodeBody,
+// so patchAppserver and the handler read the same fields.
+func decodePatch(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		patch, ok := readMergePatch(w, r)
+		if !ok {
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), bodyKey{}, patch)))
+	})
+}
+
+// patchAppserver reads the appserver_id key of the patch decoded by
+// decodePatch.
+func patchAppserver(r *http.Request) string {
+	var id string
+	if patch, ok := r.Context().Value(bodyKey{}).(*mergePatch); ok {
+		json.Unmarshal(patch.fields["appserver_id"], &id)
+	}
+	return id
+}
+
+// decodeMergePatch returns the patch decoded by decodePatch, or reads it from
+// the request body when the route has no decodePatch.
+func decodeMergePatch(w http.ResponseWriter, r *http.Request) (*mergePatch, bool) {
+	if patch, ok := r.Context().Value(bodyKey{}).(*mergePatch); ok {
+		return patch, true
+	}
+	return readMergePatch(w, r)
+}
+
+// readMergePatch reads a merge patch from the request body. It renders the
+// error response and returns false when the body is not a JSON object.
+func readMergePatch(w http.ResponseWriter, r *http.Request) (*mergePatch, bool) {
+	body, err := readBody(w, r, mergePatchContentType, jsonContentType)
+	if err != nil {
+		return nil, false
+	}
+
+	var fields map[string]json.RawMessage
+	if err := decodeStrict(body, &fields); err != nil {
+		renderDecodeError(w, r, err, nil)
+		return nil, false
+	}
+	if fields == nil {
+		RenderError(w, r, http.StatusUnprocessableEntity, problem.InvalidBody, "Request body must be a JSON object.")
+		return nil, false
+	}
+	return &mergePatch{fields: fields}, true
+}
+
+// take decodes field into dst when the patch sets it and adds path to the
+// update mask. A null leaves dst at its zero value, which resets the field,
+// unless the field is not nullable.
+func (p *mergePatch) take(field string, dst any, path string, nullable bool) bool {
+	raw, ok := p.fields[field]
+	if !ok {
+		return false
+	}
+	delete(p.fields, field)
+
+	if bytes.Equal(bytes.TrimSpace(raw), []byte("null")) {
+		if !nullable {
+			p.errs = append(p.errs, FieldError{Field: field, Message: "cannot be null"})
+			return false
+		}
+	} else if err := json.Unmarshal(raw, dst); err != nil {
+		p.errs = append(p.errs, FieldError{Field: field, Message: "has an invalid value"})
+		return false
+	}
+
+	if path != "" && !slices.Contains(p.paths, path) {
+		p.paths = append(p.paths, path)
+	}
+	return true
+}
+
+// name takes a name field and holds it to the min_len and max_len rules of
+// the create requests, which the patched resources do not carry.
+func (p *mergePatch) name(field string, dst *string, path string) {
+	if !p.take(field, dst, path, false) {
+		return
+	}
+
+	switch n := utf8.RuneCountInString(*dst); {
+	case n < 1:
+		p.errs = append(p.errs, FieldError{Field: field, Message: "value length must be at least 1 characters"})
+	case n > maxNameLength:
+		p.errs = append(p.errs, FieldError{Field: field, Message: fmt.Sprintf("value length must be at most %d characters", maxNameLength)})
+	}
+}
+
+// key takes a field identifying the patched resource. It is required and
+// never part of the update mask.
+func (p *mergePatch) key(field string, dst *string) {
+	if _, ok := p.fields[field]; !ok {
+		p.errs = append(p.errs, FieldError{Field: field, Message: "is required"})
+		return
+	}
+	p.take(field, dst, "", false)
+}
+
+// updatedAt takes the updated_at value the client last read, which makes the
+// update conditional on the resource not having changed since.
+func (p *mergePatch) updatedAt() *timestamppb.Timestamp {
+	var t time.Time
+	if !p.take("updated_at", &t, "", false) {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// done rejects fields no handler took and patches that change nothing. It
+// renders the error response and returns false when the patch is invalid.
+func (p *mergePatch) done(w http.ResponseWriter, r *http.Request) bool {
+	unknown := make([]string, 0, len(p.fields))
+	for field := range p.fields {
+		unknown = append(unknown, field)
+	}
+	sort.Strings(unknown)
+	for _, field := range unknown {
+		p.errs = append(p.errs, FieldError{Field: field, Message: "cannot be updated"})
+	}
+
+	if len(p.errs) > 0 {
+		RenderFieldErrors(w, r, "Invalid patch.", p.errs)
+		return false
+	}
+	if len(p.paths) == 0 {
+		RenderError(w, r, http.StatusBadRequest, problem.BadRequest, "No fields to update.")
+		return false
+	}
+	return true
+}
+
+// mask returns the update mask of the fields taken from the patch.
+func (p *mergePatch) mask() *fieldmaskpb.FieldMask {
+	return &fieldmaskpb.FieldMask{Paths: p.paths}
+}
@@ -0,0 +1,69 @@
+package api
+
+import (
+	"net/http"
+
+	"mistapi/src/auth"
+	"mistapi/src/permissions"
+	"mistapi/src/protos/v1/appserver"
+	"mistapi/src/protos/v1/appserver_role"
+	"mistapi/src/protos/v1/appserver_role_sub"
+	"mistapi/src/service"
+)
+
+// requirePermissions rejects callers lacking perms in the appserver found by
+// appserverID before the handler calls the backend.
+func requirePermissions(appserverID permissions.AppserverID, perms ...permissions.Permission) func(http.Handler) http.Handler {
+	return permissions.Require(loadPermissions, appserverID, perms...)
+}
+
+// requirePermissionsIfSet is requirePermissions for routes where the
+// appserver is optional, see permissions.RequireIfSet.
+func requirePermissionsIfSet(appserverID permissions.AppserverID, perms ...permissions.Permission) func(http.Handler) http.Handler {
+	return permissions.RequireIfSet(loadPermissions, appserverID, perms...)
+}
+
+// loadPermissions resolves the caller's permissions in appserverID from the
+// backend. Owners get every permission without looking at their roles.
+func loadPermissions(w http.ResponseWriter, r *http.Request, appserverID string) (permissions.Set, bool) {
+	// an appserver_id read from the body has not been validated by the handler yet
+	req := &appserver.GetByIdRequest{Id: appserverID}
+	if !validateRequest(w, r, req) {
+		return permissions.Set{}, false
+	}
+
+	authT, _ := auth.GetAuthotizationToken(r)
+	ctx, cancel := service.SetupGrpcHeaders(r.Context(), authT.Token, BackendTimeout(r))
+	defer cancel()
+
+	c := service.NewGrpcClient()
+	server, err := c.GetAppserverClient().GetById(ctx, req)
+	if err != nil {
+		HandleGrpcError(w, r, err)
+		return permissions.Set{}, false
+	}
+
+	if server.Appserver.IsOwner {
+		return permissions.Set{Owner: true}, true
+	}
+
+	roleSubs, err := c.GetAppserverRoleSubClient().ListServerRoleSubs(
+		ctx, &appserver_role_sub.ListServerRoleSubsRequest{AppserverId: appserverID},
+	)
+	if err != nil {
+		HandleGrpcError(w, r, err)
+		return permissions.Set{}, false
+	}
+
+	roles, err := c.GetAppserverRoleClient().ListServerRoles(
+		ctx, &appserver_role.ListServerRolesRequest{AppserverId: appserverID},
+	)
+	if err != nil {
+		HandleGrpcError(w, r, err)
+		return permissions.Set{}, false
+	}
+
+	return permissions.Effective(
+		appserverID, authT.Claims.UserID, roleSubs.AppserverRoleSubs, roles.AppserverRoles,
+	), true
+}
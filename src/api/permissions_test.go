@@ -0,0 +1,247 @@
+package api_test
+
+import (
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"mistapi/src/api"
+	"mistapi/src/permissions"
+	"mistapi/src/protos/v1/appserver"
+	"mistapi/src/protos/v1/appserver_role"
+	"mistapi/src/protos/v1/appserver_role_sub"
+	"mistapi/src/protos/v1/appserver_sub"
+	"mistapi/src/protos/v1/channel"
+	"mistapi/src/protos/v1/channel_role"
+	"mistapi/src/testutil"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// tokenClaims are the optional claims of signedTestToken.
+type tokenClaims struct {
+	// Subject defaults to the user ID.
+	Subject  string
+	Username string
+}
+
+// signedTestToken returns a bearer token for userID accepted by testConfig.
+func signedTestToken(t *testing.T, userID string, optional ...tokenClaims) string {
+	var extra tokenClaims
+	if len(optional) > 0 {
+		extra = optional[0]
+	}
+	if extra.Subject == "" {
+		extra.Subject = userID
+	}
+
+	cfg := testConfig().Auth
+	claims := jwt.MapClaims{
+		"iss":     cfg.JWTIssuer,
+		"aud":     cfg.JWTAudience,
+		"sub":     extra.Subject,
+		"user_id": userID,
+		"exp":     time.Now().Add(time.Hour).Unix(),
+		"iat":     time.Now().Unix(),
+	}
+	if extra.Username != "" {
+		claims["username"] = extra.Username
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(cfg.JWTSecretKey))
+	require.NoError(t, err)
+	return "Bearer " + token
+}
+
+func TestRoutePermissions(t *testing.T) {
+	log.SetOutput(new(strings.Builder))
+
+	url := "/api/v1/appservers/00000000-0000-0000-0000-000000000a04/channels/00000000-0000-0000-0000-000000000a0b"
+
+	mockMembership := func(isOwner bool, roleMask int64) (*testutil.MockClient, *testutil.MockChannelService) {
+		mockAppserver := new(testutil.MockAppserverService)
+		mockAppserver.On("GetById", mock.Anything, &appserver.GetByIdRequest{Id: "00000000-0000-0000-0000-000000000a04"}).Return(
+			&appserver.GetByIdResponse{Appserver: &appserver.Appserver{Id: "00000000-0000-0000-0000-000000000a04", IsOwner: isOwner}}, nil)
+		mockAppserver.On("GetById", mock.Anything, &appserver.GetByIdRequest{Id: "00000000-0000-0000-0000-000000000a05"}).Return(
+			&appserver.GetByIdResponse{Appserver: &appserver.Appserver{Id: "00000000-0000-0000-0000-000000000a05"}}, nil)
+
+		mockRoleSubs := new(testutil.MockAppserverRoleSubService)
+		mockRoleSubs.On("ListServerRoleSubs", mock.Anything, mock.Anything).Return(
+			&appserver_role_sub.ListServerRoleSubsResponse{AppserverRoleSubs: []*appserver_role_sub.AppserverRoleSub{
+				{AppuserId: "00000000-0000-0000-0000-000000000a09", AppserverId: "00000000-0000-0000-0000-000000000a04", AppserverRoleId: "00000000-0000-0000-0000-000000000a0a"},
+			}}, nil)
+
+		mockRoles := new(testutil.MockAppserverRoleService)
+		mockRoles.On("ListServerRoles", mock.Anything, mock.Anything).Return(
+			&appserver_role.ListServerRolesResponse{AppserverRoles: []*appserver_role.AppserverRole{
+				{Id: "00000000-0000-0000-0000-000000000a0a", AppserverId: "00000000-0000-0000-0000-000000000a04", AppserverPermissionMask: roleMask},
+			}}, nil)
+
+		mockChannel := new(testutil.MockChannelService)
+		mockChannel.On("Delete", mock.Anything, &channel.DeleteRequest{Id: "00000000-0000-0000-0000-000000000a0b", AppserverId: "00000000-0000-0000-0000-000000000a04"}).Return(
+			&channel.DeleteResponse{}, nil)
+
+		// the caller's own sub is a0f, Delete is only mocked for it
+		mockSubs := new(testutil.MockAppserverSubService)
+		mockSubs.On("ListUserServerSubs", mock.Anything, mock.Anything).Return(
+			&appserver_sub.ListUserServerSubsResponse{Appservers: []*appserver_sub.AppserverAndSub{
+				{SubId: "00000000-0000-0000-0000-000000000a0f", Appserver: &appserver.Appserver{Id: "00000000-0000-0000-0000-000000000a04"}},
+			}}, nil)
+		mockSubs.On("Delete", mock.Anything, &appserver_sub.DeleteRequest{Id: "00000000-0000-0000-0000-000000000a0f", AppserverId: "00000000-0000-0000-0000-000000000a04"}).Return(
+			&appserver_sub.DeleteResponse{}, nil)
+
+		mockClient := new(testutil.MockClient)
+		mockClient.On("GetAppserverClient").Return(mockAppserver)
+		mockClient.On("GetAppserverSubClient").Return(mockSubs)
+		mockClient.On("GetAppserverRoleSubClient").Return(mockRoleSubs)
+		mockClient.On("GetAppserverRoleClient").Return(mockRoles)
+		mockClient.On("GetChannelClient").Return(mockChannel)
+		return mockClient, mockChannel
+	}
+
+	tests := []struct {
+		name           string
+		isOwner        bool
+		roleMask       int64
+		expectedStatus int
+	}{
+		{"Success:role_granting_the_permission_is_allowed", false, permissions.ManageChannels.Bit, http.StatusNoContent},
+		{"Success:owner_is_allowed_without_roles", true, 0, http.StatusNoContent},
+		{"Error:role_without_the_permission_is_forbidden", false, permissions.ManageRoles.Bit, http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// ARRANGE
+			mockClient, mockChannel := mockMembership(tt.isOwner, tt.roleMask)
+			testutil.MockGrpcClient(t, mockClient)
+
+			r := api.SetupRouter(testConfig(), api.NewReadiness(0, time.Second, nil), testVerifier(t))
+			req := httptest.NewRequest(http.MethodDelete, url, nil)
+			req.Header.Set("Authorization", signedTestToken(t, "00000000-0000-0000-0000-000000000a09"))
+			rr := httptest.NewRecorder()
+
+			// ACT
+			r.ServeHTTP(rr, req)
+
+			// ASSERT
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			if tt.expectedStatus == http.StatusForbidden {
+				mockChannel.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+			}
+		})
+	}
+
+	t.Run("Error:channel_patch_without_the_permission_is_forbidden", func(t *testing.T) {
+		// ARRANGE
+		mockClient, mockChannel := mockMembership(false, permissions.ManageRoles.Bit)
+		testutil.MockGrpcClient(t, mockClient)
+
+		r := api.SetupRouter(testConfig(), api.NewReadiness(0, time.Second, nil), testVerifier(t))
+		req := httptest.NewRequest(http.MethodPatch, "/api/v1/channels/00000000-0000-0000-0000-000000000a0b",
+			strings.NewReader(`{"appserver_id":"00000000-0000-0000-0000-000000000a04","name":"renamed"}`))
+		req.Header.Set("Authorization", signedTestToken(t, "00000000-0000-0000-0000-000000000a09"))
+		req.Header.Set("Content-Type", "application/merge-patch+json")
+		rr := httptest.NewRecorder()
+
+		// ACT
+		r.ServeHTTP(rr, req)
+
+		// ASSERT
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+		mockChannel.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+	t.Run("Error:permissions_apply_to_the_decoded_appserver", func(t *testing.T) {
+		// ARRANGE
+		mockClient, mockChannel := mockMembership(false, permissions.ManageChannels.Bit)
+		testutil.MockGrpcClient(t, mockClient)
+
+		// encoding/json keeps the last case-insensitive match of a key
+		r := api.SetupRouter(testConfig(), api.NewReadiness(0, time.Second, nil), testVerifier(t))
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/channels", strings.NewReader(`{"name":"general",
+			"appserver_id":"00000000-0000-0000-0000-000000000a04","APPSERVER_ID":"00000000-0000-0000-0000-000000000a05"}`))
+		req.Header.Set("Authorization", signedTestToken(t, "00000000-0000-0000-0000-000000000a09"))
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+
+		// ACT
+		r.ServeHTTP(rr, req)
+
+		// ASSERT
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+		mockChannel.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+	deletes := []struct {
+		name string
+		url  string
+	}{
+		{"Error:role_delete_needs_manage_roles", "/api/v1/appserver-roles/00000000-0000-0000-0000-000000000a0a"},
+		{"Error:channel_role_delete_needs_manage_roles", "/api/v1/channel-roles/00000000-0000-0000-0000-000000000a0c"},
+		{"Error:role_sub_delete_needs_assign_roles", "/api/v1/appserver-role-subs/00000000-0000-0000-0000-000000000a0d"},
+		{"Error:sub_delete_needs_kick_members", "/api/v1/appserver-subs/00000000-0000-0000-0000-000000000a0e"},
+	}
+
+	for _, tt := range deletes {
+		t.Run(tt.name, func(t *testing.T) {
+			// ARRANGE
+			mockClient, _ := mockMembership(false, permissions.ManageChannels.Bit)
+			testutil.MockGrpcClient(t, mockClient)
+
+			r := api.SetupRouter(testConfig(), api.NewReadiness(0, time.Second, nil), testVerifier(t))
+			req := httptest.NewRequest(http.MethodDelete, tt.url+"?appserver_id=00000000-0000-0000-0000-000000000a04", nil)
+			req.Header.Set("Authorization", signedTestToken(t, "00000000-0000-0000-0000-000000000a09"))
+			rr := httptest.NewRecorder()
+
+			// ACT
+			r.ServeHTTP(rr, req)
+
+			// ASSERT
+			assert.Equal(t, http.StatusForbidden, rr.Code)
+			mockClient.AssertNotCalled(t, "GetChannelRoleClient")
+		})
+	}
+
+	t.Run("Success:members_delete_their_own_sub_without_kick_members", func(t *testing.T) {
+		// ARRANGE
+		mockClient, _ := mockMembership(false, 0)
+		testutil.MockGrpcClient(t, mockClient)
+
+		r := api.SetupRouter(testConfig(), api.NewReadiness(0, time.Second, nil), testVerifier(t))
+		req := httptest.NewRequest(http.MethodDelete, "/api/v1/appserver-subs/00000000-0000-0000-0000-000000000a0f", nil)
+		req.Header.Set("Authorization", signedTestToken(t, "00000000-0000-0000-0000-000000000a09"))
+		rr := httptest.NewRecorder()
+
+		// ACT
+		r.ServeHTTP(rr, req)
+
+		// ASSERT
+		assert.Equal(t, http.StatusNoContent, rr.Code)
+	})
+
+	t.Run("Success:deletes_without_appserver_are_left_to_the_backend", func(t *testing.T) {
+		// ARRANGE
+		mockClient, _ := mockMembership(false, 0)
+		mockChannelRoles := new(testutil.MockChannelRoleService)
+		mockChannelRoles.On("Delete", mock.Anything, &channel_role.DeleteRequest{Id: "00000000-0000-0000-0000-000000000a0c"}).Return(
+			&channel_role.DeleteResponse{}, nil)
+		mockClient.On("GetChannelRoleClient").Return(mockChannelRoles)
+		testutil.MockGrpcClient(t, mockClient)
+
+		r := api.SetupRouter(testConfig(), api.NewReadiness(0, time.Second, nil), testVerifier(t))
+		req := httptest.NewRequest(http.MethodDelete, "/api/v1/channel-roles/00000000-0000-0000-0000-000000000a0c", nil)
+		req.Header.Set("Authorization", signedTestToken(t, "00000000-0000-0000-0000-000000000a09"))
+		rr := httptest.NewRecorder()
+
+		// ACT
+		r.ServeHTTP(rr, req)
+
+		// ASSERT
+		assert.Equal(t, http.StatusNoContent, rr.Code)
+		mockChannelRoles.AssertExpectations(t)
+	})
+}
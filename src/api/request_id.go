@@ -0,0 +1,33 @@
+package api
+
+import (
+	"net/http"
+	"regexp"
+
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+// validRequestID limits trusted incoming IDs to something safe to log and to
+// forward as gRPC metadata.
+var validRequestID = regexp.MustCompile(`^[A-Za-z0-9._:/-]{1,128}$`)
+
+// RequestIDMiddleware assigns a request ID and echoes it in the X-Request-Id
+// response header. An incoming X-Request-Id is only reused when trustIncoming
+// is set, e.g. behind a proxy that generates it.
+func RequestIDMiddleware(trustIncoming bool) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		echo := middleware.RequestID(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set(middleware.RequestIDHeader, middleware.GetReqID(r.Context()))
+			next.ServeHTTP(w, r)
+		}))
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			incoming := r.Header.Get(middleware.RequestIDHeader)
+			if incoming != "" && (!trustIncoming || !validRequestID.MatchString(incoming)) {
+				r = r.Clone(r.Context())
+				r.Header.Del(middleware.RequestIDHeader)
+			}
+			echo.ServeHTTP(w, r)
+		})
+	}
+}
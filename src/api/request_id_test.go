@@ -0,0 +1,81 @@
+package api_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"mistapi/src/api"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRequestIDMiddleware(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		trust        bool
+		incoming     string
+		expectReused bool
+	}{
+		{"Success:generates_id_without_header", false, "", false},
+		{"Success:ignores_untrusted_header", false, "client-id", false},
+		{"Success:reuses_trusted_header", true, "proxy-id-1", true},
+		{"Success:ignores_trusted_but_invalid_header", true, "bad id!", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// ARRANGE
+			var seen string
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				seen = middleware.GetReqID(r.Context())
+			})
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.incoming != "" {
+				req.Header.Set(middleware.RequestIDHeader, tt.incoming)
+			}
+			rr := httptest.NewRecorder()
+
+			// ACT
+			api.RequestIDMiddleware(tt.trust)(next).ServeHTTP(rr, req)
+
+			// ASSERT
+			assert.NotEmpty(t, seen)
+			assert.Equal(t, seen, rr.Header().Get(middleware.RequestIDHeader))
+			if tt.expectReused {
+				assert.Equal(t, tt.incoming, seen)
+			} else {
+				assert.NotEqual(t, tt.incoming, seen)
+			}
+		})
+	}
+}
+
+func TestRenderErrorIncludesRequestID(t *testing.T) {
+	t.Parallel()
+
+	// ARRANGE
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/some-endpoint", nil)
+	r = r.WithContext(context.WithValue(r.Context(), middleware.RequestIDKey, "req-123"))
+
+	// ACT
+	api.HandleGrpcError(w, r, status.Error(codes.NotFound, "simulated error"))
+
+	// ASSERT
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.JSONEq(t, `{
+		"type": "urn:mist:problem:not_found",
+		"title": "Not found",
+		"status": 404,
+		"code": "not_found",
+		"detail": "Not found.",
+		"request_id": "req-123"
+	}`, w.Body.String())
+}
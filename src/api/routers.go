@@ -1,14 +1,25 @@
 package api
 
 import (
+	"context"
+	"errors"
 	"fmt"
-	"log"
+	"log/slog"
+	"net"
 	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	_ "mistapi/docs"
 	"mistapi/src/auth"
+	"mistapi/src/config"
+	"mistapi/src/events"
+	"mistapi/src/logging"
+	"mistapi/src/metrics"
 	"mistapi/src/service"
+	"mistapi/src/tracing"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
@@ -16,56 +27,185 @@ import (
 	httpSwagger "github.com/swaggo/http-swagger"
 )
 
-func StartService() {
+// StartService runs the API until the process receives SIGINT or SIGTERM.
+func StartService(cfg *config.Config) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
+	return Run(ctx, cfg)
+}
+
+// Run listens on the configured port and serves the API until ctx is done.
+func Run(ctx context.Context, cfg *config.Config) error {
+	ln, err := net.Listen("tcp", fmt.Sprintf(":%s", cfg.App.Port))
+	if err != nil {
+		return fmt.Errorf("listening on port %s: %w", cfg.App.Port, err)
+	}
+
+	return Serve(ctx, cfg, ln)
+}
+
+// Serve serves the API on ln until ctx is done. It then stops accepting new
+// connections, waits up to cfg.App.ShutdownTimeout for in-flight requests and
+// finally closes the gRPC connection to the backend. ln is closed when Serve
+// returns, including when it fails to start.
+func Serve(ctx context.Context, cfg *config.Config, ln net.Listener) error {
 	// initialize grpc connection
-	service.GetGrpcClientConnection()
+	if _, err := service.GetGrpcClientConnection(cfg.Backend); err != nil {
+		ln.Close()
+		return err
+	}
 	defer service.CloseGrpcConnection()
 
-	r := SetupRouter()
+	verifier, err := auth.NewVerifier(cfg.Auth)
+	if err != nil {
+		ln.Close()
+		return fmt.Errorf("setting up token verification: %w", err)
+	}
+	defer verifier.Close()
+
+	readiness := NewReadiness(cfg.Health.CacheTTL, cfg.Health.CheckTimeout, map[string]HealthCheck{
+		"backend": func(ctx context.Context) (string, error) {
+			state, err := service.CheckBackendHealth(ctx)
+			return state.String(), err
+		},
+	})
+
+	r := SetupRouter(cfg, readiness, verifier)
 
 	// Apply CORS
 	handler := cors.New(cors.Options{
 		AllowedOrigins:   []string{"http://localhost:5173"}, // TODO: fix the origin for the app
-		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"Authorization", "Content-Type"},
+		AllowedMethods:   []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
+		AllowedHeaders:   []string{"Authorization", "Content-Type", RequestTimeoutHeader, middleware.RequestIDHeader},
+		ExposedHeaders:   []string{middleware.RequestIDHeader},
 		AllowCredentials: true, // if sending cookies/auth headers
 	}).Handler(r)
 
-	addr := fmt.Sprintf(":%s", os.Getenv("APP_PORT"))
-	// TODO: use better logging solution
-	log.Printf("Server running at %s\n", addr)
-	http.ListenAndServe(addr, handler)
+	// Shutdown waits for requests to return without cancelling them, so the
+	// event streams watch shuttingDown and end once it starts
+	shutdown := make(chan struct{})
+	baseCtx := context.WithValue(context.Background(), shutdownKey{}, (<-chan struct{})(shutdown))
+	apiServer := &http.Server{Handler: handler, BaseContext: func(net.Listener) context.Context { return baseCtx }}
+	apiServer.RegisterOnShutdown(func() { close(shutdown) })
+
+	servers := []*http.Server{apiServer}
+	listeners := []net.Listener{ln}
+
+	if cfg.Metrics.Enabled && cfg.Metrics.Port != "" {
+		mln, err := net.Listen("tcp", fmt.Sprintf(":%s", cfg.Metrics.Port))
+		if err != nil {
+			ln.Close()
+			return fmt.Errorf("listening on metrics port %s: %w", cfg.Metrics.Port, err)
+		}
+		servers = append(servers, &http.Server{Handler: metrics.Handler()})
+		listeners = append(listeners, mln)
+	}
+
+	serveErr := make(chan error, len(servers))
+	for i, srv := range servers {
+		go func() {
+			serveErr <- srv.Serve(listeners[i])
+		}()
+		slog.Info("Server running", "addr", listeners[i].Addr().String())
+	}
+
+	var runErr error
+	select {
+	case err := <-serveErr:
+		if !errors.Is(err, http.ErrServerClosed) {
+			runErr = fmt.Errorf("serving http: %w", err)
+		}
+	case <-ctx.Done():
+		// report not-ready and keep serving for a while, so load balancers stop
+		// sending new traffic before the listener closes
+		readiness.SetDraining()
+		slog.Info("Shutting down, readiness set to draining", "delay", cfg.App.ShutdownDelay)
+		time.Sleep(cfg.App.ShutdownDelay)
+	}
+
+	slog.Info("Shutting down, draining in-flight requests", "timeout", cfg.App.ShutdownTimeout)
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.App.ShutdownTimeout)
+	defer cancel()
+
+	// the API server is first so /metrics stays up while requests drain
+	for _, srv := range servers {
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			runErr = errors.Join(runErr, fmt.Errorf("shutting down http server: %w", err))
+		}
+	}
+
+	return runErr
 }
 
-func SetupRouter() *chi.Mux {
+type shutdownKey struct{}
+
+// shuttingDown is closed once the server serving ctx starts shutting down.
+// It is nil, and never ready, for requests not served by Serve.
+func shuttingDown(ctx context.Context) <-chan struct{} {
+	done, _ := ctx.Value(shutdownKey{}).(<-chan struct{})
+	return done
+}
+
+func SetupRouter(cfg *config.Config, readiness *Readiness, verifier *auth.Verifier) *chi.Mux {
 	r := chi.NewRouter()
 
 	// SETUP MIDDDLEWARES
-	r.Use(middleware.Logger)
-	r.Use(middleware.RequestID)
+	r.Use(RequestIDMiddleware(cfg.App.TrustRequestID))
+	r.Use(logging.Middleware)
+	r.Use(tracing.Middleware)
+	r.Use(metrics.Middleware)
 
 	// Mount the user router
 	r.Get("/health", HealthHandler)
+	r.Get("/health/live", HealthHandler)
+	r.Get("/health/ready", readiness.Handler)
 
-	r.Route("/api/", func(r chi.Router) {
-		r.Use(auth.AuthenticateMiddleware)
+	// only served here when there is no dedicated metrics listener
+	if cfg.Metrics.Enabled && cfg.Metrics.Port == "" {
+		r.Handle("/metrics", metrics.Handler())
+	}
+
+	broker := events.NewBroker()
 
-		r.Mount("/v1/appservers", appserverRouter())
+	r.Route("/api/", func(r chi.Router) {
+		r.Use(WebsocketTokenMiddleware)
+		r.Use(auth.AuthenticateMiddleware(verifier))
+		r.Use(DeadlineMiddleware(cfg.Backend))
+		r.Use(BodyLimitMiddleware(cfg.App.MaxBodySize))
+		if cfg.Auth.Provisioning.Enabled {
+			r.Use(ProvisionMiddleware(cfg.Auth))
+		}
+
+		r.Use(events.Middleware(broker))
+		r.Use(CursorMiddleware(NewCursors(cfg.App.CursorSecret)))
+
+		r.Get("/v1/me", MeHandler(cfg.Auth.UsernameClaim))
+		r.Method(http.MethodGet, "/v1/ws", WebsocketHandler(broker))
+		r.Mount("/v1/appservers", appserverRouter(broker))
 		r.Mount("/v1/appserver-roles", appserverRoleRouter())
 		r.Mount("/v1/appserver-role-subs", appserverRoleSubRouter())
 		r.Mount("/v1/appserver-subs", appserverSubRouter())
 		r.Mount("/v1/channels", channelRouter())
 		r.Mount("/v1/channel-roles", channelRoleRouter())
+		r.Mount("/v1/admin", adminRouter(verifier.Revocations(), cfg.Auth.Revocation.AdminUserIDs))
 	})
 
 	// TODO: change the localhost domain
 	r.Get("/swagger/*", httpSwagger.Handler(
-		httpSwagger.URL(fmt.Sprintf("http://localhost:%s/swagger/doc.json", os.Getenv("APP_PORT")))))
+		httpSwagger.URL(fmt.Sprintf("http://localhost:%s/swagger/doc.json", cfg.App.Port))))
 
 	return r
 }
 
+// HealthHandler godoc
+// @Summary      Liveness probe
+// @Description  Reports that the process is up, without checking dependencies
+// @Tags         health
+// @Produce      plain
+// @Success      200
+// @Router       /health/live [get]
 func HealthHandler(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 	w.Write([]byte("ok"))
@@ -1,40 +1,174 @@
 package api_test
 
 import (
+	"context"
+	"fmt"
+	"net"
 	"net/http"
-	"os"
+	"net/http/httptest"
 	"testing"
 	"time"
 
 	"mistapi/src/api"
+	"mistapi/src/auth"
+	"mistapi/src/config"
+	"mistapi/src/protos/v1/appserver"
+	"mistapi/src/protos/v1/appserver_sub"
 	"mistapi/src/testutil"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
-func TestStartService(t *testing.T) {
+func testConfig() *config.Config {
+	return &config.Config{
+		App:     config.AppConfig{Port: "0", ShutdownTimeout: time.Second, MaxBodySize: 1 << 20},
+		Backend: config.BackendConfig{URL: "localhost:50051", TLS: config.BackendTLSConfig{Insecure: true}},
+		Auth: config.AuthConfig{
+			JWTSecretKey:  "test-secret-key",
+			JWTAudience:   "test-audience",
+			JWTIssuer:     "test-issuer",
+			JWTAlgorithms: []string{"HS256"},
+		},
+	}
+}
+
+func testVerifier(t *testing.T) *auth.Verifier {
+	v, err := auth.NewVerifier(testConfig().Auth)
+	require.NoError(t, err)
+	return v
+}
+
+func TestServe(t *testing.T) {
 	t.Parallel()
 
-	// ARANGE
-	mockClient := new(testutil.MockClient)
-	testutil.MockGrpcClient(t, mockClient)
+	t.Run("Success:serves_requests_and_shuts_down_on_cancel", func(t *testing.T) {
+		// ARANGE
+		mockClient := new(testutil.MockClient)
+		testutil.MockGrpcClient(t, mockClient)
 
-	// Set test env port
-	os.Setenv("APP_PORT", "8081")
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
 
-	// ACT
-	go func() {
-		api.StartService() // This will block so run in goroutine
-	}()
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error, 1)
 
-	// Wait for server to start
-	time.Sleep(500 * time.Millisecond)
+		// ACT
+		go func() {
+			done <- api.Serve(ctx, testConfig(), ln)
+		}()
 
-	resp, err := http.Get("http://localhost:8081/health")
-	require.NoError(t, err)
-	defer resp.Body.Close()
+		resp, err := http.Get(fmt.Sprintf("http://%s/health", ln.Addr()))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		cancel()
+
+		// ASSERT
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		select {
+		case err := <-done:
+			assert.NoError(t, err)
+		case <-time.After(5 * time.Second):
+			t.Fatal("server did not shut down")
+		}
+	})
+
+	t.Run("Success:open_event_streams_do_not_hold_up_shutdown", func(t *testing.T) {
+		// ARRANGE
+		memberMocks(t, []*appserver_sub.AppserverAndSub{
+			{SubId: "sub", Appserver: &appserver.Appserver{Id: wsServerID}},
+		})
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error, 1)
+		go func() {
+			done <- api.Serve(ctx, testConfig(), ln)
+		}()
+
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("http://%s/api/v1/appservers/%s/events", ln.Addr(), wsServerID), nil)
+		require.NoError(t, err)
+		req.Header.Set("Authorization", signedTestToken(t, "user"))
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		// ACT
+		cancel()
+
+		// ASSERT
+		select {
+		case err := <-done:
+			assert.NoError(t, err)
+		case <-time.After(testConfig().App.ShutdownTimeout):
+			t.Fatal("server did not shut down")
+		}
+	})
+
+	t.Run("Success:metrics_are_served_outside_of_the_api_routes", func(t *testing.T) {
+		// ARRANGE
+		cfg := testConfig()
+		cfg.Metrics.Enabled = true
+		r := api.SetupRouter(cfg, api.NewReadiness(0, time.Second, nil), testVerifier(t))
+		rr := httptest.NewRecorder()
+
+		// ACT
+		r.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+		// ASSERT
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), "mistapi_http_requests_in_flight")
+	})
+
+	t.Run("Success:metrics_are_not_served_when_disabled", func(t *testing.T) {
+		// ARRANGE
+		r := api.SetupRouter(testConfig(), api.NewReadiness(0, time.Second, nil), testVerifier(t))
+		rr := httptest.NewRecorder()
+
+		// ACT
+		r.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+		// ASSERT
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Error:listener_is_closed_when_the_metrics_port_is_taken", func(t *testing.T) {
+		// ARRANGE
+		testutil.MockGrpcClient(t, new(testutil.MockClient))
+		taken, err := net.Listen("tcp", ":0")
+		require.NoError(t, err)
+		defer taken.Close()
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+
+		cfg := testConfig()
+		cfg.Metrics.Enabled = true
+		cfg.Metrics.Port = fmt.Sprint(taken.Addr().(*net.TCPAddr).Port)
+
+		// ACT
+		err = api.Serve(context.Background(), cfg, ln)
+
+		// ASSERT
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "listening on metrics port")
+		_, err = ln.Accept()
+		assert.ErrorIs(t, err, net.ErrClosed)
+	})
+
+	t.Run("Error:run_returns_listen_errors", func(t *testing.T) {
+		// ARRANGE
+		cfg := testConfig()
+		cfg.App.Port = "invalid-port"
+
+		// ACT
+		err := api.Run(context.Background(), cfg)
 
-	// ASSERT
-	assert.Equal(t, http.StatusOK, resp.StatusCode)
+		// ASSERT
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "listening on port invalid-port")
+	})
 }
@@ -0,0 +1,151 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"mistapi/src/auth"
+	"mistapi/src/events"
+	"mistapi/src/logging"
+	"mistapi/src/problem"
+
+	"github.com/go-chi/chi/v5"
+)
+
+const (
+	// sseHeartbeat keeps proxies from closing idle streams.
+	sseHeartbeat = 15 * time.Second
+	sseBuffer    = 64
+)
+
+// AppserverEventsHandler godoc
+// @Summary      Stream appserver events
+// @Description  Server-Sent Events stream of the channel, role and membership changes of an appserver, for
+// @Description  clients that cannot use the WebSocket. Events of private channels only reach members allowed to
+// @Description  view them. Reconnecting with Last-Event-ID replays the missed events still retained; when some
+// @Description  were lost a resync event is sent first and the client should fetch the appserver again. The stream
+// @Description  closes when the caller is removed from the appserver.
+// @Tags         events
+// @Produce      text/event-stream
+// @Param        id             path    string  true   "Appserver ID"
+// @Param        Last-Event-ID  header  string  false  "ID of the last event received"
+// @Security     BearerAuth
+// @Success      200
+// @Failure      403 {object} ErrorResponse
+// @Router       /api/v1/appservers/{id}/events [get]
+func AppserverEventsHandler(broker *events.Broker) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		sId := chi.URLParam(r, "id")
+		logger := logging.FromContext(r.Context())
+
+		var lastID uint64
+		resume := r.Header.Get("Last-Event-ID")
+		if resume != "" {
+			id, err := strconv.ParseUint(resume, 10, 64)
+			if err != nil {
+				RenderError(w, r, http.StatusBadRequest, problem.BadRequest, "Invalid Last-Event-ID.")
+				return
+			}
+			lastID = id
+		}
+
+		view, err := loadEventView(r, sId)
+		if errors.Is(err, errNotMember) {
+			RenderError(w, r, http.StatusForbidden, problem.PermissionDenied, "Forbidden.")
+			return
+		}
+		if err != nil {
+			HandleGrpcError(w, r, err)
+			return
+		}
+
+		authT, _ := auth.GetAuthotizationToken(r)
+		rc := http.NewResponseController(w)
+		sub := broker.Subscribe(authT.Claims.UserID, sseBuffer)
+		defer broker.Unsubscribe(sub)
+
+		var missed []events.Event
+		complete := true
+		if resume != "" {
+			missed, complete = broker.JoinAfter(sub, sId, view, lastID)
+		} else {
+			broker.Join(sub, sId, view)
+		}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("X-Accel-Buffering", "no")
+		w.WriteHeader(http.StatusOK)
+
+		if !complete {
+			fmt.Fprintf(w, "event: resync\ndata: {\"appserver_id\":%q}\n\n", sId)
+		}
+		for _, e := range missed {
+			if err := writeEvent(w, e); err != nil {
+				return
+			}
+		}
+		if err := rc.Flush(); err != nil {
+			logger.Warn("Streaming is not supported", "error", err)
+			return
+		}
+
+		heartbeat := time.NewTicker(sseHeartbeat)
+		defer heartbeat.Stop()
+
+		var expired <-chan time.Time
+		if authT.Claims.ExpiresAt != nil {
+			timer := time.NewTimer(time.Until(authT.Claims.ExpiresAt.Time))
+			defer timer.Stop()
+			expired = timer.C
+		}
+
+		for {
+			select {
+			case e := <-sub.Events():
+				err = writeEvent(w, e)
+			case <-heartbeat.C:
+				_, err = fmt.Fprint(w, ": heartbeat\n\n")
+			case <-sub.Refresh():
+				if len(refreshViews(r, broker, sub)) > 0 {
+					// the client gets a 403 when it reconnects after leaving
+					logger.Info("Closing event stream after a membership change")
+					return
+				}
+				continue
+			case <-sub.Dropped():
+				// the client resumes from the replay buffer when it reconnects
+				logger.Warn("Closing event stream of a client falling behind")
+				return
+			case <-expired:
+				return
+			case <-shuttingDown(r.Context()):
+				// the client reconnects to another instance with Last-Event-ID
+				return
+			case <-r.Context().Done():
+				return
+			}
+
+			if err == nil {
+				err = rc.Flush()
+			}
+			if err != nil {
+				logger.Info("Error while writing event stream", "error", err)
+				return
+			}
+		}
+	}
+}
+
+func writeEvent(w http.ResponseWriter, e events.Event) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", e.ID, e.Type, data)
+	return err
+}
@@ -0,0 +1,148 @@
+package api_test
+
+import (
+	"bufio"
+	"context"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"mistapi/src/api"
+	"mistapi/src/protos/v1/appserver"
+	"mistapi/src/protos/v1/appserver_sub"
+	"mistapi/src/protos/v1/channel"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// openEvents requests the event stream of wsServerID as user.
+func openEvents(t *testing.T, srv *httptest.Server, lastEventID string) *http.Response {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"/api/v1/appservers/"+wsServerID+"/events", nil)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", signedTestToken(t, "user"))
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() { res.Body.Close() })
+	return res
+}
+
+// readSSE returns the fields of the next message of the stream.
+func readSSE(t *testing.T, r *bufio.Reader) map[string]string {
+	fields := map[string]string{}
+	for {
+		line, err := r.ReadString('\n')
+		require.NoError(t, err)
+		line = strings.TrimSuffix(line, "\n")
+		if line == "" {
+			return fields
+		}
+		name, value, _ := strings.Cut(line, ": ")
+		fields[name] = value
+	}
+}
+
+func TestAppserverEventsHandler(t *testing.T) {
+	log.SetOutput(new(strings.Builder))
+
+	setup := func(t *testing.T, subs []*appserver_sub.AppserverAndSub) *httptest.Server {
+		mockChannel := memberMocks(t, subs)
+		mockChannel.On("Create", mock.Anything, mock.Anything).Return(&channel.CreateResponse{
+			Channel: &channel.Channel{Id: "general", Name: "general", AppserverId: wsServerID},
+		}, nil)
+
+		srv := httptest.NewServer(api.SetupRouter(testConfig(), api.NewReadiness(0, time.Second, nil), testVerifier(t)))
+		t.Cleanup(srv.Close)
+		return srv
+	}
+	member := []*appserver_sub.AppserverAndSub{{SubId: "sub", Appserver: &appserver.Appserver{Id: wsServerID}}}
+
+	createChannel := func(t *testing.T, srv *httptest.Server) {
+		body := marshallPayload(t, map[string]any{"name": "general", "appserver_id": wsServerID})
+		req, err := http.NewRequest(http.MethodPost, srv.URL+"/api/v1/channels", body)
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", signedTestToken(t, "user"))
+		res, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		res.Body.Close()
+		require.Equal(t, http.StatusCreated, res.StatusCode)
+	}
+
+	t.Run("Success:streams_events", func(t *testing.T) {
+		// ARRANGE
+		srv := setup(t, member)
+		res := openEvents(t, srv, "")
+
+		// ACT
+		createChannel(t, srv)
+		msg := readSSE(t, bufio.NewReader(res.Body))
+
+		// ASSERT
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, "text/event-stream", res.Header.Get("Content-Type"))
+		assert.Equal(t, "1", msg["id"])
+		assert.Equal(t, "channel.created", msg["event"])
+		assert.Contains(t, msg["data"], `"channel_id":"general"`)
+	})
+
+	t.Run("Success:resumes_after_last_event_id", func(t *testing.T) {
+		// ARRANGE
+		srv := setup(t, member)
+		createChannel(t, srv)
+		createChannel(t, srv)
+
+		// ACT
+		res := openEvents(t, srv, "1")
+		msg := readSSE(t, bufio.NewReader(res.Body))
+
+		// ASSERT
+		assert.Equal(t, "2", msg["id"])
+		assert.Equal(t, "channel.created", msg["event"])
+	})
+
+	t.Run("Success:lost_events_ask_for_a_resync", func(t *testing.T) {
+		// ARRANGE
+		srv := setup(t, member)
+
+		// ACT
+		res := openEvents(t, srv, "42")
+		msg := readSSE(t, bufio.NewReader(res.Body))
+
+		// ASSERT
+		assert.Equal(t, "resync", msg["event"])
+	})
+
+	t.Run("Error:non_member_is_forbidden", func(t *testing.T) {
+		// ARRANGE
+		srv := setup(t, nil)
+
+		// ACT
+		res := openEvents(t, srv, "")
+
+		// ASSERT
+		assert.Equal(t, http.StatusForbidden, res.StatusCode)
+	})
+
+	t.Run("Error:invalid_last_event_id", func(t *testing.T) {
+		// ARRANGE
+		srv := setup(t, member)
+
+		// ACT
+		res := openEvents(t, srv, "abc")
+
+		// ASSERT
+		assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+	})
+}
@@ -1,13 +1,18 @@
 package api
 
 import (
-	"encoding/json"
-	"log"
+	"errors"
+	"log/slog"
 	"net/http"
+	"time"
+
+	"mistapi/src/logging"
+	"mistapi/src/problem"
+	"mistapi/src/service"
 
-	"github.com/go-chi/render"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // ----- GRPC -----
@@ -19,68 +24,91 @@ type DataResponse struct {
 	Data interface{} `json:"data,omitempty"`
 }
 
-type ErrorResponse struct {
-	Detail string `json:"detail,omitempty"`
-}
+// ErrorResponse is the application/problem+json body of every error.
+type ErrorResponse = problem.Problem
+
+// FieldError points at the request field that failed validation.
+type FieldError = problem.FieldError
 
 func HandleGrpcError(w http.ResponseWriter, r *http.Request, err error) {
 	s, _ := status.FromError(err)
 
-	// Log the error
-	log.Printf("Error from service: %v\n", err)
-
 	// Map gRPC status code to HTTP status and error message
-	httpStatus, message := mapGrpcStatusToHTTP(s.Code(), s.Message())
+	httpStatus, code, message := mapGrpcStatusToHTTP(s.Code(), s.Message())
+	if errors.Is(err, service.ErrCircuitOpen) {
+		// the call never reached the backend, tell the client to back off
+		httpStatus, code, message = http.StatusServiceUnavailable, problem.ServiceUnavailable,
+			"Server is temporarily unavailable."
+	}
+
+	level := slog.LevelWarn
+	if httpStatus >= http.StatusInternalServerError {
+		level = slog.LevelError
+	}
+	logging.FromContext(r.Context()).Log(r.Context(), level, "Error from service",
+		"error", err,
+		"grpc_code", s.Code().String(),
+		"http_status", httpStatus,
+	)
+
+	var violations []FieldError
+	if s.Code() == codes.InvalidArgument {
+		if violations = problem.FromStatus(s); len(violations) > 0 {
+			code = problem.ValidationFailed
+		}
+	}
 
 	// Set the HTTP status and send the error response
-	render.Status(r, httpStatus)
-	render.JSON(w, r, &ErrorResponse{Detail: message})
+	res := problem.New(httpStatus, code, message)
+	res.Errors = violations
+	problem.Write(w, r, res)
 }
 
-func mapGrpcStatusToHTTP(code codes.Code, grpcMessage string) (int, string) {
+func mapGrpcStatusToHTTP(code codes.Code, grpcMessage string) (int, problem.Code, string) {
 	switch code {
 	case codes.Unavailable:
-		return http.StatusBadGateway, "Server is unresponsive."
+		return http.StatusBadGateway, problem.BackendUnavailable, "Server is unresponsive."
 	case codes.DeadlineExceeded:
-		return http.StatusBadGateway, "Server timed out."
+		return http.StatusBadGateway, problem.BackendTimeout, "Server timed out."
 	case codes.Canceled:
-		return http.StatusBadGateway, "Server error."
+		return http.StatusBadGateway, problem.BackendError, "Server error."
 	case codes.Unauthenticated:
-		return http.StatusUnauthorized, grpcMessage
+		return http.StatusUnauthorized, problem.Unauthenticated, grpcMessage
 	case codes.NotFound:
-		return http.StatusNotFound, "Not found."
+		return http.StatusNotFound, problem.NotFound, "Not found."
 	case codes.AlreadyExists:
-		return http.StatusConflict, "Resource already exists."
+		return http.StatusConflict, problem.AlreadyExists, "Resource already exists."
 	case codes.PermissionDenied:
-		return http.StatusConflict, grpcMessage
+		return http.StatusForbidden, problem.PermissionDenied, grpcMessage
 	case codes.InvalidArgument:
-		return http.StatusBadRequest, grpcMessage
+		return http.StatusBadRequest, problem.BadRequest, grpcMessage
+	case codes.Aborted:
+		return http.StatusPreconditionFailed, problem.PreconditionFailed,
+			"Resource was modified, fetch it again before updating."
 	default:
-		// Log unhandled gRPC error codes
-		log.Printf("Unhandled gRPC error code: %v\n", code)
-		return http.StatusInternalServerError, "Internal Server Error."
+		return http.StatusInternalServerError, problem.Internal, "Internal Server Error."
 	}
 }
 
-func DecodeRequestBody(w http.ResponseWriter, r *http.Request, bind interface{}) error {
-	err := json.NewDecoder(r.Body).Decode(&bind)
-	if err != nil {
-		// TODO: use better logging solution
-		log.Printf("Error while decoding: %v\n", err)
-
-		// If there is an error in decoding, return 400 Bad Request
-		render.Status(r, http.StatusUnprocessableEntity)
-		render.JSON(w, r, CreateErrorResponse("Invalid attributes provided."))
+// RenderError writes a problem without field errors.
+func RenderError(w http.ResponseWriter, r *http.Request, status int, code problem.Code, detail string) {
+	problem.Error(w, r, status, code, detail)
+}
 
-		return err
-	}
-	return nil
+// RenderFieldErrors writes a 400 problem listing the invalid fields.
+func RenderFieldErrors(w http.ResponseWriter, r *http.Request, detail string, errs []FieldError) {
+	res := problem.New(http.StatusBadRequest, problem.ValidationFailed, detail)
+	res.Errors = errs
+	problem.Write(w, r, res)
 }
 
-func CreateErrorResponse(detail string) *ErrorResponse {
-	return &ErrorResponse{
-		Detail: detail,
+// protoTime converts an optional proto timestamp for a JSON response.
+func protoTime(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
 	}
+	t := ts.AsTime()
+	return &t
 }
 
 func CreateResponse(data interface{}) *DataResponse {
@@ -7,17 +7,20 @@ import (
 	"log"
 	"net/http"
 	"net/http/httptest"
-	"os"
 	"strings"
 	"testing"
 	"time"
 
 	"mistapi/src/api"
 	"mistapi/src/auth"
+	"mistapi/src/problem"
+	"mistapi/src/service"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -32,8 +35,8 @@ type CreateTokenParams struct {
 func addContextHeaders(req *http.Request) *http.Request {
 	claims := &auth.CustomJWTClaims{
 		RegisteredClaims: jwt.RegisteredClaims{
-			Issuer:   os.Getenv("MIST_PY_API_JWT_ISSUER"),
-			Audience: []string{os.Getenv("MIST_PY_API_JWT_AUDIENCE")},
+			Issuer:   "test-issuer",
+			Audience: []string{"test-audience"},
 
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(1 * time.Hour)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
@@ -57,6 +60,20 @@ func withURLParam(r *http.Request, key, value string) *http.Request {
 	return r
 }
 
+func mergePatchRequest(t *testing.T, url string, body string) *http.Request {
+	req, err := http.NewRequest(http.MethodPatch, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("error creating request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/merge-patch+json")
+	return addContextHeaders(req)
+}
+
+// listResponse is the response of a list fitting in one page.
+func listResponse(data interface{}) *api.DataResponse {
+	return &api.DataResponse{Meta: api.PageMeta{}, Data: data}
+}
+
 func marshallPayload(t *testing.T, data interface{}) *bytes.Buffer {
 	body, err := json.Marshal(data)
 	if err != nil {
@@ -82,17 +99,19 @@ func TestHandleGrpcError(t *testing.T) {
 		name           string
 		grpcCode       codes.Code
 		expectedStatus int
+		expectedCode   problem.Code
 		expectedDetail string
 	}{
-		{"Unavailable", codes.Unavailable, http.StatusBadGateway, "Server is unresponsive."},
-		{"DeadlineExceeded", codes.DeadlineExceeded, http.StatusBadGateway, "Server timed out."},
-		{"Canceled", codes.Canceled, http.StatusBadGateway, "Server error."},
-		{"Unauthenticated", codes.Unauthenticated, http.StatusUnauthorized, "simulated error"},
-		{"NotFound", codes.NotFound, http.StatusNotFound, "Not found."},
-		{"AlreadyExists", codes.AlreadyExists, http.StatusConflict, "Resource already exists."},
-		{"AlreadyExists", codes.PermissionDenied, http.StatusConflict, "simulated error"},
-		{"InvalidArgument", codes.InvalidArgument, http.StatusBadRequest, "simulated error"},
-		{"UnhandledCode", codes.DataLoss, http.StatusInternalServerError, "Internal Server Error."},
+		{"Unavailable", codes.Unavailable, http.StatusBadGateway, problem.BackendUnavailable, "Server is unresponsive."},
+		{"DeadlineExceeded", codes.DeadlineExceeded, http.StatusBadGateway, problem.BackendTimeout, "Server timed out."},
+		{"Canceled", codes.Canceled, http.StatusBadGateway, problem.BackendError, "Server error."},
+		{"Unauthenticated", codes.Unauthenticated, http.StatusUnauthorized, problem.Unauthenticated, "simulated error"},
+		{"NotFound", codes.NotFound, http.StatusNotFound, problem.NotFound, "Not found."},
+		{"AlreadyExists", codes.AlreadyExists, http.StatusConflict, problem.AlreadyExists, "Resource already exists."},
+		{"PermissionDenied", codes.PermissionDenied, http.StatusForbidden, problem.PermissionDenied, "simulated error"},
+		{"InvalidArgument", codes.InvalidArgument, http.StatusBadRequest, problem.BadRequest, "simulated error"},
+		{"Aborted", codes.Aborted, http.StatusPreconditionFailed, problem.PreconditionFailed, "Resource was modified, fetch it again before updating."},
+		{"UnhandledCode", codes.DataLoss, http.StatusInternalServerError, problem.Internal, "Internal Server Error."},
 	}
 
 	for _, tt := range tests {
@@ -109,7 +128,7 @@ func TestHandleGrpcError(t *testing.T) {
 			api.HandleGrpcError(w, r, err)
 
 			// ASSERT
-			expected, _ := json.Marshal(api.CreateErrorResponse(tt.expectedDetail))
+			expected, _ := json.Marshal(problem.New(tt.expectedStatus, tt.expectedCode, tt.expectedDetail))
 
 			assert.Equal(t, tt.expectedStatus, w.Code)
 			assert.JSONEq(t,
@@ -118,4 +137,41 @@ func TestHandleGrpcError(t *testing.T) {
 			)
 		})
 	}
+	t.Run("CircuitOpen", func(t *testing.T) {
+		// ARRANGE
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/some-endpoint", nil)
+
+		// ACT
+		api.HandleGrpcError(w, r, service.ErrCircuitOpen)
+
+		// ASSERT
+		expected, _ := json.Marshal(problem.New(http.StatusServiceUnavailable, problem.ServiceUnavailable, "Server is temporarily unavailable."))
+
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+		assert.JSONEq(t, string(expected), w.Body.String())
+	})
+
+	t.Run("InvalidArgumentWithDetails", func(t *testing.T) {
+		// ARRANGE
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/some-endpoint", nil)
+		s, err := status.New(codes.InvalidArgument, "invalid request").WithDetails(&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: "name", Description: "value length must be at least 1 characters"},
+			},
+		})
+		require.NoError(t, err)
+
+		// ACT
+		api.HandleGrpcError(w, r, s.Err())
+
+		// ASSERT
+		expected := problem.New(http.StatusBadRequest, problem.ValidationFailed, "invalid request")
+		expected.Errors = []api.FieldError{{Field: "name", Message: "value length must be at least 1 characters"}}
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, problem.ContentType, w.Header().Get("Content-Type"))
+		assert.JSONEq(t, marshallResponse(t, expected), w.Body.String())
+	})
 }
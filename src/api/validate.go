@@ -0,0 +1,98 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"regexp"
+	"slices"
+
+	"mistapi/src/logging"
+	"mistapi/src/problem"
+
+	"buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	"buf.build/go/protovalidate"
+	"github.com/go-chi/chi/v5"
+	"google.golang.org/protobuf/proto"
+)
+
+// idParams are the route parameters holding UUIDs.
+var idParams = []string{"id", "sid", "cid", "userId"}
+
+// uuidPattern is the string.uuid rule of protovalidate.
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// validateRequest checks req against the buf.validate rules of its proto, so
+// invalid input is rejected without a round-trip to the backend. It writes a
+// 400 listing the violated fields and returns false when req is invalid.
+func validateRequest(w http.ResponseWriter, r *http.Request, req proto.Message) bool {
+	err := validateMessage(r, req)
+	if err == nil {
+		return true
+	}
+
+	var verr *protovalidate.ValidationError
+	errors.As(err, &verr)
+	RenderFieldErrors(w, r, "Invalid request.", problem.Violations(verr.ToProto().GetViolations()))
+	return false
+}
+
+// validateOptionalAppserver is validateRequest for requests whose
+// appserver_id the client may leave out, the backend then working out the
+// appserver from the resource itself.
+func validateOptionalAppserver(w http.ResponseWriter, r *http.Request, req proto.Message, appserverID string) bool {
+	err := validateMessage(r, req)
+	if err == nil {
+		return true
+	}
+
+	var verr *protovalidate.ValidationError
+	errors.As(err, &verr)
+	violations := verr.ToProto().GetViolations()
+	if appserverID == "" {
+		violations = slices.DeleteFunc(violations, func(v *validate.Violation) bool {
+			return problem.FieldPath(v.GetField()) == "appserver_id"
+		})
+		if len(violations) == 0 {
+			return true
+		}
+	}
+	RenderFieldErrors(w, r, "Invalid request.", problem.Violations(violations))
+	return false
+}
+
+// validateMessage returns the *protovalidate.ValidationError of an invalid
+// req. Rules failing to compile or evaluate are logged and leave the decision
+// to the backend.
+func validateMessage(r *http.Request, req proto.Message) error {
+	err := protovalidate.Validate(req)
+
+	var verr *protovalidate.ValidationError
+	if err != nil && !errors.As(err, &verr) {
+		logging.FromContext(r.Context()).Error("Error while validating request", "error", err)
+		return nil
+	}
+	return err
+}
+
+// ValidatePathParams rejects requests whose ID route parameters are not
+// UUIDs, like the backend would, before permissions are loaded or a request
+// is built. Parameters are only known once a route matches, so it must wrap
+// routes (With, Group) rather than be used on a router.
+func ValidatePathParams(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var errs []FieldError
+		if rctx := chi.RouteContext(r.Context()); rctx != nil {
+			for i, key := range rctx.URLParams.Keys {
+				if slices.Contains(idParams, key) && !uuidPattern.MatchString(rctx.URLParams.Values[i]) {
+					errs = append(errs, FieldError{Field: key, Message: "value must be a valid UUID"})
+				}
+			}
+		}
+
+		if len(errs) > 0 {
+			RenderFieldErrors(w, r, "Invalid path parameters.", errs)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
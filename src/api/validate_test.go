@@ -0,0 +1,126 @@
+package api_test
+
+import (
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"mistapi/src/api"
+	"mistapi/src/protos/v1/channel_role"
+	"mistapi/src/testutil"
+	"mistapi/src/types"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatePathParams(t *testing.T) {
+	log.SetOutput(new(strings.Builder))
+
+	r := chi.NewRouter()
+	r.With(api.ValidatePathParams).Get("/{sid}/channels/{cid}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	t.Run("Success:uuids_reach_the_handler", func(t *testing.T) {
+		// ARRANGE
+		rr := httptest.NewRecorder()
+		url := "/3F1C2A4E-5B6D-4E7F-8A9B-0C1D2E3F4A5B/channels/00000000-0000-0000-0000-000000000001"
+
+		// ACT
+		r.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, url, nil))
+
+		// ASSERT
+		assert.Equal(t, http.StatusNoContent, rr.Code)
+	})
+
+	t.Run("Error:ids_that_are_not_uuids_are_rejected", func(t *testing.T) {
+		// ARRANGE
+		rr := httptest.NewRecorder()
+
+		// ACT
+		r.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/server/channels/general", nil))
+
+		// ASSERT
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.JSONEq(t, `{"type":"urn:mist:problem:validation_failed","title":"Validation failed","status":400,
+			"code":"validation_failed","detail":"Invalid path parameters.","errors":[
+			{"field":"sid","message":"value must be a valid UUID"},
+			{"field":"cid","message":"value must be a valid UUID"}
+		]}`, rr.Body.String())
+	})
+}
+
+func TestValidateRequest(t *testing.T) {
+	log.SetOutput(new(strings.Builder))
+
+	t.Run("Error:invalid_request_is_not_sent_to_the_backend", func(t *testing.T) {
+		// ARRANGE
+		mockClient := new(testutil.MockClient)
+		testutil.MockGrpcClient(t, mockClient)
+
+		payload := marshallPayload(t, types.ChannelCreate{Name: strings.Repeat("a", 65), AppserverId: "server"})
+		req, err := http.NewRequest(http.MethodPost, apiUrl, payload)
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+
+		// ACT
+		api.ChannelCreateHandler(rr, addContextHeaders(req))
+
+		// ASSERT
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.JSONEq(t, `{"type":"urn:mist:problem:validation_failed","title":"Validation failed","status":400,
+			"code":"validation_failed","detail":"Invalid request.","errors":[
+			{"field":"name","message":"value length must be at most 64 characters"},
+			{"field":"appserver_id","message":"value must be a valid UUID"}
+		]}`, rr.Body.String())
+		mockClient.AssertNotCalled(t, "GetChannelClient")
+	})
+
+	t.Run("Error:invalid_query_parameter_is_reported", func(t *testing.T) {
+		// ARRANGE
+		mockClient := new(testutil.MockClient)
+		testutil.MockGrpcClient(t, mockClient)
+
+		r := chi.NewRouter()
+		r.Delete("/{id}", api.ChannelRoleDeleteHandler)
+		req, err := http.NewRequest(http.MethodDelete, "/00000000-0000-0000-0000-000000000001?appserver_id=1", nil)
+		require.NoError(t, err)
+		rr := httptest.NewRecorder()
+
+		// ACT
+		r.ServeHTTP(rr, addContextHeaders(req))
+
+		// ASSERT
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"errors":[{"field":"appserver_id","message":"value must be a valid UUID"}]`)
+		mockClient.AssertNotCalled(t, "GetChannelRoleClient")
+	})
+
+	t.Run("Success:missing_optional_appserver_is_not_reported", func(t *testing.T) {
+		// ARRANGE
+		mockService := new(testutil.MockChannelRoleService)
+		mockService.On("Delete", mock.Anything, &channel_role.DeleteRequest{Id: "00000000-0000-0000-0000-000000000001"}).Return(
+			&channel_role.DeleteResponse{}, nil)
+		mockClient := new(testutil.MockClient)
+		mockClient.On("GetChannelRoleClient").Return(mockService)
+		testutil.MockGrpcClient(t, mockClient)
+
+		r := chi.NewRouter()
+		r.Delete("/{id}", api.ChannelRoleDeleteHandler)
+		req, err := http.NewRequest(http.MethodDelete, "/00000000-0000-0000-0000-000000000001", nil)
+		require.NoError(t, err)
+		rr := httptest.NewRecorder()
+
+		// ACT
+		r.ServeHTTP(rr, addContextHeaders(req))
+
+		// ASSERT
+		assert.Equal(t, http.StatusNoContent, rr.Code)
+	})
+}
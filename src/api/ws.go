@@ -0,0 +1,335 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"time"
+
+	"mistapi/src/auth"
+	"mistapi/src/events"
+	"mistapi/src/logging"
+	"mistapi/src/permissions"
+	"mistapi/src/protos/v1/appserver"
+	"mistapi/src/protos/v1/appserver_role"
+	"mistapi/src/protos/v1/appserver_role_sub"
+	"mistapi/src/protos/v1/appserver_sub"
+	"mistapi/src/protos/v1/channel"
+	"mistapi/src/protos/v1/channel_role"
+	"mistapi/src/service"
+
+	"buf.build/go/protovalidate"
+	"golang.org/x/net/websocket"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// websocketBuffer is how many events a connection may lag behind before
+	// it is closed.
+	websocketBuffer = 64
+	// maxWebsocketSubscriptions bounds the appservers a connection follows.
+	maxWebsocketSubscriptions = 50
+	websocketWriteTimeout     = 10 * time.Second
+	// websocketTokenParam carries the token of browsers, which cannot set
+	// headers on WebSocket requests.
+	websocketTokenParam = "access_token"
+)
+
+var errNotMember = errors.New("not a member of the appserver")
+
+// wsClientMessage is a message sent by the client.
+type wsClientMessage struct {
+	// Type is subscribe, unsubscribe or ping.
+	Type        string `json:"type"`
+	AppserverID string `json:"appserver_id,omitempty"`
+}
+
+// wsServerMessage answers a client message. Events are sent as they are.
+type wsServerMessage struct {
+	// Type is subscribed, unsubscribed, pong or error.
+	Type        string `json:"type"`
+	AppserverID string `json:"appserver_id,omitempty"`
+	Detail      string `json:"detail,omitempty"`
+}
+
+// WebsocketTokenMiddleware lets WebSocket upgrade requests pass the token in
+// the access_token query parameter when they have no Authorization header.
+func WebsocketTokenMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := r.URL.Query().Get(websocketTokenParam)
+		if token != "" && r.Header.Get("Authorization") == "" && r.Header.Get("Upgrade") == "websocket" {
+			r.Header.Set("Authorization", "Bearer "+token)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// WebsocketHandler godoc
+// @Summary      Stream appserver events
+// @Description  Upgrade to a WebSocket streaming the events of the appservers the client subscribes to.
+// @Description  Send {"type":"subscribe","appserver_id":"..."} or {"type":"unsubscribe","appserver_id":"..."};
+// @Description  events of private channels only reach members allowed to view them. A member removed from an
+// @Description  appserver gets an unsubscribed message. Browsers may pass the token in the access_token query
+// @Description  parameter. The connection closes when the token expires.
+// @Tags         events
+// @Security     BearerAuth
+// @Param        access_token  query  string  false  "Token, for clients that cannot set headers"
+// @Success      101
+// @Router       /api/v1/ws [get]
+func WebsocketHandler(broker *events.Broker) http.Handler {
+	return websocket.Server{
+		// the token is checked instead of the origin, cookies are not used
+		Handshake: func(*websocket.Config, *http.Request) error { return nil },
+		Handler: func(ws *websocket.Conn) {
+			serveWebsocket(ws, broker)
+		},
+	}
+}
+
+func serveWebsocket(ws *websocket.Conn, broker *events.Broker) {
+	r := ws.Request()
+	authT, _ := auth.GetAuthotizationToken(r)
+	logger := logging.FromContext(r.Context())
+
+	sub := broker.Subscribe(authT.Claims.UserID, websocketBuffer)
+	defer broker.Unsubscribe(sub)
+
+	replies := make(chan wsServerMessage, 1)
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		readWebsocket(ws, broker, sub, replies)
+	}()
+
+	var expired <-chan time.Time
+	if authT.Claims.ExpiresAt != nil {
+		timer := time.NewTimer(time.Until(authT.Claims.ExpiresAt.Time))
+		defer timer.Stop()
+		expired = timer.C
+	}
+
+	for {
+		var msg any
+		select {
+		case e := <-sub.Events():
+			msg = e
+		case reply := <-replies:
+			msg = reply
+		case <-sub.Refresh():
+			for _, sId := range refreshViews(r, broker, sub) {
+				msg := wsServerMessage{Type: "unsubscribed", AppserverID: sId, Detail: "Membership or roles changed."}
+				if err := sendWebsocket(ws, msg); err != nil {
+					logger.Info("Error while writing to WebSocket", "error", err)
+					return
+				}
+			}
+			continue
+		case <-sub.Dropped():
+			logger.Warn("Closing WebSocket of a client falling behind")
+			sendWebsocket(ws, wsServerMessage{Type: "error", Detail: "Too many pending events."})
+			return
+		case <-expired:
+			sendWebsocket(ws, wsServerMessage{Type: "error", Detail: "Token expired."})
+			return
+		case <-shuttingDown(r.Context()):
+			sendWebsocket(ws, wsServerMessage{Type: "error", Detail: "Server shutting down."})
+			return
+		case <-closed:
+			return
+		}
+
+		if err := sendWebsocket(ws, msg); err != nil {
+			logger.Info("Error while writing to WebSocket", "error", err)
+			return
+		}
+	}
+}
+
+func sendWebsocket(ws *websocket.Conn, msg any) error {
+	ws.SetWriteDeadline(time.Now().Add(websocketWriteTimeout))
+	return websocket.JSON.Send(ws, msg)
+}
+
+// readWebsocket handles the client messages until the connection breaks.
+func readWebsocket(ws *websocket.Conn, broker *events.Broker, sub *events.Subscriber, replies chan<- wsServerMessage) {
+	r := ws.Request()
+
+	for {
+		var msg wsClientMessage
+		if err := websocket.JSON.Receive(ws, &msg); err != nil {
+			return
+		}
+
+		reply := wsServerMessage{Type: "error", AppserverID: msg.AppserverID}
+		switch {
+		case msg.Type == "ping":
+			reply = wsServerMessage{Type: "pong"}
+		case msg.Type == "unsubscribe":
+			broker.Leave(sub, msg.AppserverID)
+			reply.Type = "unsubscribed"
+		case msg.Type != "subscribe":
+			reply.Detail = "Unknown message type."
+		case msg.AppserverID == "":
+			reply.Detail = "Missing appserver_id."
+		case tooManySubscriptions(broker, sub, msg.AppserverID):
+			reply.Detail = "Too many subscriptions."
+		default:
+			view, err := loadEventView(r, msg.AppserverID)
+			if err != nil {
+				reply.Detail = eventViewError(r, err)
+				break
+			}
+			broker.Join(sub, msg.AppserverID, view)
+			reply.Type = "subscribed"
+		}
+
+		select {
+		case replies <- reply:
+		case <-r.Context().Done():
+			return
+		}
+	}
+}
+
+func tooManySubscriptions(broker *events.Broker, sub *events.Subscriber, sId string) bool {
+	joined := broker.Joined(sub)
+	return !slices.Contains(joined, sId) && len(joined) >= maxWebsocketSubscriptions
+}
+
+// refreshViews reloads the views of sub the broker marked stale after the
+// membership or roles of the caller changed. It returns the appservers sub no
+// longer follows, because the caller left them or the view could not be
+// reloaded.
+func refreshViews(r *http.Request, broker *events.Broker, sub *events.Subscriber) []string {
+	var left []string
+	for _, sId := range broker.Stale(sub) {
+		if !slices.Contains(broker.Joined(sub), sId) {
+			left = append(left, sId)
+			continue
+		}
+
+		view, err := loadEventView(r, sId)
+		if err != nil {
+			logging.FromContext(r.Context()).Info("Error while reloading event view", "appserver_id", sId, "error", err)
+			broker.Leave(sub, sId)
+			left = append(left, sId)
+			continue
+		}
+		if !broker.Update(sub, sId, view) {
+			left = append(left, sId)
+		}
+	}
+	return left
+}
+
+func eventViewError(r *http.Request, err error) string {
+	if errors.Is(err, errNotMember) {
+		return "Forbidden."
+	}
+	var verr *protovalidate.ValidationError
+	if errors.As(err, &verr) {
+		return "Invalid appserver_id."
+	}
+
+	s, _ := status.FromError(err)
+	_, _, message := mapGrpcStatusToHTTP(s.Code(), s.Message())
+	logging.FromContext(r.Context()).Warn("Error while subscribing to events", "error", err)
+	return message
+}
+
+// loadEventView checks that the caller is a member of the appserver and
+// resolves which of its private channels the caller may view. Visibility is
+// a snapshot, reloaded by refreshViews when the caller's roles change.
+func loadEventView(r *http.Request, sId string) (*events.View, error) {
+	authT, _ := auth.GetAuthotizationToken(r)
+	ctx, cancel := service.SetupGrpcHeaders(r.Context(), authT.Token, BackendTimeout(r))
+	defer cancel()
+
+	req := &appserver.GetByIdRequest{Id: sId}
+	if err := validateMessage(r, req); err != nil {
+		return nil, err
+	}
+
+	c := service.NewGrpcClient()
+	server, err := c.GetAppserverClient().GetById(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if server.Appserver.IsOwner {
+		return &events.View{Owner: true}, nil
+	}
+
+	if err := checkMembership(ctx, c, sId); err != nil {
+		return nil, err
+	}
+
+	channels, err := c.GetChannelClient().ListServerChannels(
+		ctx, &channel.ListServerChannelsRequest{AppserverId: sId},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	view := &events.View{Private: map[string]bool{}}
+	var roles []*appserver_role.AppserverRole
+	for i, ch := range channels.Channels {
+		if !ch.IsPrivate {
+			continue
+		}
+
+		if roles == nil {
+			if roles, err = loadUserRoles(ctx, c, sId, authT.Claims.UserID); err != nil {
+				return nil, err
+			}
+		}
+
+		channelRoles, err := c.GetChannelRoleClient().ListChannelRoles(
+			ctx, &channel_role.ListChannelRolesRequest{ChannelId: ch.Id, AppserverId: sId},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		chRoles := permissions.ChannelRoles(channels.Channels[i], channelRoles.ChannelRoles, roles)
+		view.Private[ch.Id] = permissions.Combine(chRoles).Has(permissions.ViewChannel)
+	}
+	return view, nil
+}
+
+func checkMembership(ctx context.Context, c service.GrpcClient, sId string) error {
+	subs, err := c.GetAppserverSubClient().ListUserServerSubs(ctx, &appserver_sub.ListUserServerSubsRequest{})
+	if err != nil {
+		return err
+	}
+
+	for _, s := range subs.Appservers {
+		if s.Appserver.GetId() == sId {
+			return nil
+		}
+	}
+	return errNotMember
+}
+
+// loadUserRoles returns the roles of userId in the appserver, never nil.
+func loadUserRoles(ctx context.Context, c service.GrpcClient, sId, userId string) ([]*appserver_role.AppserverRole, error) {
+	roleSubs, err := c.GetAppserverRoleSubClient().ListServerRoleSubs(
+		ctx, &appserver_role_sub.ListServerRoleSubsRequest{AppserverId: sId},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := c.GetAppserverRoleClient().ListServerRoles(
+		ctx, &appserver_role.ListServerRolesRequest{AppserverId: sId},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	res := permissions.UserRoles(sId, userId, roleSubs.AppserverRoleSubs, roles.AppserverRoles)
+	if res == nil {
+		res = []*appserver_role.AppserverRole{}
+	}
+	return res, nil
+}
@@ -0,0 +1,231 @@
+package api_test
+
+import (
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"mistapi/src/api"
+	"mistapi/src/permissions"
+	"mistapi/src/protos/v1/appserver"
+	"mistapi/src/protos/v1/appserver_role"
+	"mistapi/src/protos/v1/appserver_role_sub"
+	"mistapi/src/protos/v1/appserver_sub"
+	"mistapi/src/protos/v1/channel"
+	"mistapi/src/protos/v1/channel_role"
+	"mistapi/src/testutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/websocket"
+)
+
+const wsServerID = "3f1c2a4e-5b6d-4e7f-8a9b-0c1d2e3f4a5b"
+
+// dialEvents opens a WebSocket to srv authenticated as user, with the token
+// in the query string as browsers send it.
+func dialEvents(t *testing.T, srv *httptest.Server, user string) *websocket.Conn {
+	token := strings.TrimPrefix(signedTestToken(t, user), "Bearer ")
+	url := "ws" + strings.TrimPrefix(srv.URL, "http") + "/api/v1/ws?access_token=" + token
+
+	ws, err := websocket.Dial(url, "", srv.URL)
+	require.NoError(t, err)
+	t.Cleanup(func() { ws.Close() })
+	return ws
+}
+
+func wsExchange(t *testing.T, ws *websocket.Conn, msg map[string]string) map[string]any {
+	require.NoError(t, websocket.JSON.Send(ws, msg))
+	return wsReceive(t, ws)
+}
+
+func wsReceive(t *testing.T, ws *websocket.Conn) map[string]any {
+	var res map[string]any
+	ws.SetReadDeadline(time.Now().Add(5 * time.Second))
+	require.NoError(t, websocket.JSON.Receive(ws, &res))
+	return res
+}
+
+// memberMocks sets up user as a member of wsServerID holding a role that
+// manages channels and kicks members, with a private channel the role is not
+// linked to. The sub of user is "sub".
+func memberMocks(t *testing.T, subs []*appserver_sub.AppserverAndSub) *testutil.MockChannelService {
+	mockAppserver := new(testutil.MockAppserverService)
+	mockAppserver.On("GetById", mock.Anything, mock.Anything).Return(&appserver.GetByIdResponse{
+		Appserver: &appserver.Appserver{Id: wsServerID},
+	}, nil)
+
+	mockSub := new(testutil.MockAppserverSubService)
+	mockSub.On("ListUserServerSubs", mock.Anything, mock.Anything).Return(
+		&appserver_sub.ListUserServerSubsResponse{Appservers: subs}, nil,
+	)
+	mockSub.On("Delete", mock.Anything, mock.Anything).Return(&appserver_sub.DeleteResponse{}, nil)
+
+	mockRoleSub := new(testutil.MockAppserverRoleSubService)
+	mockRoleSub.On("ListServerRoleSubs", mock.Anything, mock.Anything).Return(
+		&appserver_role_sub.ListServerRoleSubsResponse{AppserverRoleSubs: []*appserver_role_sub.AppserverRoleSub{
+			{Id: "rs", AppuserId: "user", AppserverRoleId: "role", AppserverId: wsServerID},
+		}}, nil,
+	)
+
+	mockRole := new(testutil.MockAppserverRoleService)
+	mockRole.On("ListServerRoles", mock.Anything, mock.Anything).Return(
+		&appserver_role.ListServerRolesResponse{AppserverRoles: []*appserver_role.AppserverRole{
+			{
+				Id: "role", AppserverId: wsServerID,
+				AppserverPermissionMask: permissions.ManageChannels.Bit, SubPermissionMask: permissions.KickMembers.Bit,
+			},
+		}}, nil,
+	)
+
+	mockChannel := new(testutil.MockChannelService)
+	mockChannel.On("ListServerChannels", mock.Anything, mock.Anything).Return(
+		&channel.ListServerChannelsResponse{Channels: []*channel.Channel{
+			{Id: "hidden", AppserverId: wsServerID, IsPrivate: true},
+		}}, nil,
+	)
+
+	mockChannelRole := new(testutil.MockChannelRoleService)
+	mockChannelRole.On("ListChannelRoles", mock.Anything, mock.Anything).Return(
+		&channel_role.ListChannelRolesResponse{}, nil,
+	)
+
+	mockClient := new(testutil.MockClient)
+	mockClient.On("GetAppserverClient").Return(mockAppserver)
+	mockClient.On("GetAppserverSubClient").Return(mockSub)
+	mockClient.On("GetAppserverRoleSubClient").Return(mockRoleSub)
+	mockClient.On("GetAppserverRoleClient").Return(mockRole)
+	mockClient.On("GetChannelClient").Return(mockChannel)
+	mockClient.On("GetChannelRoleClient").Return(mockChannelRole)
+	testutil.MockGrpcClient(t, mockClient)
+
+	return mockChannel
+}
+
+func TestWebsocketHandler(t *testing.T) {
+	log.SetOutput(new(strings.Builder))
+
+	newServer := func(t *testing.T) *httptest.Server {
+		srv := httptest.NewServer(api.SetupRouter(testConfig(), api.NewReadiness(0, time.Second, nil), testVerifier(t)))
+		t.Cleanup(srv.Close)
+		return srv
+	}
+
+	t.Run("Success:member_receives_visible_events", func(t *testing.T) {
+		// ARRANGE
+		mockChannel := memberMocks(t, []*appserver_sub.AppserverAndSub{
+			{SubId: "sub", Appserver: &appserver.Appserver{Id: wsServerID}},
+		})
+		mockChannel.On("Create", mock.Anything, mock.MatchedBy(func(r *channel.CreateRequest) bool {
+			return r.IsPrivate
+		})).Return(&channel.CreateResponse{
+			Channel: &channel.Channel{Id: "secret", Name: "secret", AppserverId: wsServerID, IsPrivate: true},
+		}, nil)
+		mockChannel.On("Create", mock.Anything, mock.MatchedBy(func(r *channel.CreateRequest) bool {
+			return !r.IsPrivate
+		})).Return(&channel.CreateResponse{
+			Channel: &channel.Channel{Id: "general", Name: "general", AppserverId: wsServerID},
+		}, nil)
+
+		srv := newServer(t)
+		ws := dialEvents(t, srv, "user")
+
+		createChannel := func(private bool) {
+			body := marshallPayload(t, map[string]any{"name": "c", "appserver_id": wsServerID, "is_private": private})
+			req, err := http.NewRequest(http.MethodPost, srv.URL+"/api/v1/channels", body)
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", signedTestToken(t, "user"))
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			res.Body.Close()
+			require.Equal(t, http.StatusCreated, res.StatusCode)
+		}
+
+		// ACT
+		subscribed := wsExchange(t, ws, map[string]string{"type": "subscribe", "appserver_id": wsServerID})
+		createChannel(true)
+		createChannel(false)
+		event := wsReceive(t, ws)
+		pong := wsExchange(t, ws, map[string]string{"type": "ping"})
+
+		// ASSERT
+		assert.Equal(t, map[string]any{"type": "subscribed", "appserver_id": wsServerID}, subscribed)
+		assert.Equal(t, "channel.created", event["type"])
+		assert.Equal(t, wsServerID, event["appserver_id"])
+		assert.Equal(t, "general", event["channel_id"])
+		assert.Equal(t, "pong", pong["type"])
+	})
+
+	t.Run("Success:member_removed_from_the_appserver_is_unsubscribed", func(t *testing.T) {
+		// ARRANGE
+		memberMocks(t, []*appserver_sub.AppserverAndSub{
+			{SubId: "00000000-0000-0000-0000-000000000a01", Appserver: &appserver.Appserver{Id: wsServerID}},
+		})
+		srv := newServer(t)
+		ws := dialEvents(t, srv, "user")
+		wsExchange(t, ws, map[string]string{"type": "subscribe", "appserver_id": wsServerID})
+
+		req, err := http.NewRequest(http.MethodDelete,
+			srv.URL+"/api/v1/appserver-subs/00000000-0000-0000-0000-000000000a01?appserver_id="+wsServerID, nil)
+		require.NoError(t, err)
+		req.Header.Set("Authorization", signedTestToken(t, "user"))
+
+		// ACT
+		res, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		res.Body.Close()
+		left := wsReceive(t, ws)
+		unsubscribed := wsReceive(t, ws)
+
+		// ASSERT
+		assert.Equal(t, http.StatusNoContent, res.StatusCode)
+		assert.Equal(t, "member.left", left["type"])
+		assert.Equal(t, "user", left["data"].(map[string]any)["appuser_id"])
+		assert.Equal(t, map[string]any{
+			"type": "unsubscribed", "appserver_id": wsServerID, "detail": "Membership or roles changed.",
+		}, unsubscribed)
+	})
+
+	t.Run("Error:non_member_cannot_subscribe", func(t *testing.T) {
+		// ARRANGE
+		memberMocks(t, nil)
+		ws := dialEvents(t, newServer(t), "user")
+
+		// ACT
+		res := wsExchange(t, ws, map[string]string{"type": "subscribe", "appserver_id": wsServerID})
+
+		// ASSERT
+		assert.Equal(t, map[string]any{"type": "error", "appserver_id": wsServerID, "detail": "Forbidden."}, res)
+	})
+
+	t.Run("Error:unknown_message_type", func(t *testing.T) {
+		// ARRANGE
+		testutil.MockGrpcClient(t, new(testutil.MockClient))
+		ws := dialEvents(t, newServer(t), "user")
+
+		// ACT
+		res := wsExchange(t, ws, map[string]string{"type": "publish"})
+
+		// ASSERT
+		assert.Equal(t, map[string]any{"type": "error", "detail": "Unknown message type."}, res)
+	})
+
+	t.Run("Error:missing_token_is_rejected", func(t *testing.T) {
+		// ARRANGE
+		testutil.MockGrpcClient(t, new(testutil.MockClient))
+		srv := newServer(t)
+
+		// ACT
+		res, err := http.Get(srv.URL + "/api/v1/ws")
+
+		// ASSERT
+		require.NoError(t, err)
+		res.Body.Close()
+		assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+	})
+}
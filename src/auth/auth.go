@@ -2,12 +2,16 @@ package auth
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"log"
 	"net/http"
-	"os"
 	"strings"
 
+	"mistapi/src/config"
+	"mistapi/src/logging"
+	"mistapi/src/metrics"
+	"mistapi/src/problem"
+
 	"github.com/golang-jwt/jwt/v5"
 )
 
@@ -25,35 +29,122 @@ type contextKey string
 
 const TokenContextKey = contextKey("auth_token")
 
-func AuthenticateMiddleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		authorization := r.Header.Get("Authorization")
+var (
+	ErrInvalidFormat   = errors.New("invalid token format")
+	ErrInvalidAudience = errors.New("invalid audience claim")
+	ErrInvalidIssuer   = errors.New("invalid issuer claim")
+	ErrUnknownKey      = errors.New("no key found to verify the token")
+)
+
+// Verifier checks the signature and claims of access tokens. HMAC tokens are
+// verified with the shared secret, asymmetric ones with the JWKS keys.
+type Verifier struct {
+	cfg config.AuthConfig
+	// keys is nil when no JWKS is configured.
+	keys        *keySet
+	revocations *Revocations
+}
+
+// NewVerifier loads the JWKS, if configured, and keeps it refreshed until
+// Close is called.
+func NewVerifier(cfg config.AuthConfig) (*Verifier, error) {
+	if len(cfg.JWTAlgorithms) == 0 {
+		return nil, errors.New("no JWT signing algorithm allowed")
+	}
 
-		tac, err := AuthorizeToken(authorization)
+	store, err := NewKeyValueStore(cfg.Revocation)
+	if err != nil {
+		return nil, err
+	}
+
+	lifetime := cfg.Revocation.MaxTokenLifetime
+	if lifetime <= 0 {
+		lifetime = config.Default().Auth.Revocation.MaxTokenLifetime
+	}
 
+	v := &Verifier{cfg: cfg, revocations: NewRevocations(store, lifetime)}
+	if cfg.JWKS.URL != "" || cfg.JWKS.File != "" {
+		keys, err := newKeySet(cfg.JWKS)
 		if err != nil {
-			// TODO: use better logging solution
-			log.Printf("Unathorized API call: %v", err)
-			http.Error(w, "Unauthorized", http.StatusUnauthorized)
-			return
+			return nil, err
 		}
+		v.keys = keys
+	}
+	return v, nil
+}
 
-		// TODO: add authorization in the future
+// Revocations returns the revocations checked by AuthenticateMiddleware.
+func (v *Verifier) Revocations() *Revocations {
+	return v.revocations
+}
 
-		// Add to context
-		ctx := context.WithValue(r.Context(), TokenContextKey, tac)
-		next.ServeHTTP(w, r.WithContext(ctx))
-	})
+// Close stops refreshing the JWKS.
+func (v *Verifier) Close() {
+	if v.keys != nil {
+		v.keys.close()
+	}
 }
 
-func AuthorizeToken(authorization string) (*TokenAndClaims, error) {
+// FailureReason classifies an AuthorizeToken error for metrics.
+func FailureReason(err error) string {
+	switch {
+	case errors.Is(err, ErrInvalidFormat):
+		return "bad_format"
+	case errors.Is(err, ErrInvalidAudience):
+		return "bad_audience"
+	case errors.Is(err, ErrInvalidIssuer):
+		return "bad_issuer"
+	case errors.Is(err, ErrUnknownKey):
+		return "unknown_key"
+	case errors.Is(err, ErrTokenRevoked):
+		return "revoked"
+	case errors.Is(err, jwt.ErrTokenExpired):
+		return "expired"
+	case errors.Is(err, jwt.ErrTokenSignatureInvalid):
+		return "bad_signature"
+	case errors.Is(err, jwt.ErrTokenMalformed):
+		return "malformed"
+	default:
+		return "other"
+	}
+}
+
+func AuthenticateMiddleware(v *Verifier) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authorization := r.Header.Get("Authorization")
+
+			tac, err := AuthorizeToken(authorization, v)
+			if err == nil {
+				err = v.revocations.Check(r.Context(), tac.Claims)
+			}
+
+			if err != nil {
+				logging.FromContext(r.Context()).Warn("Unauthorized API call", "error", err)
+				metrics.RecordAuthFailure(FailureReason(err))
+				problem.Error(w, r, http.StatusUnauthorized, problem.Unauthenticated, "Unauthorized.")
+				return
+			}
+
+			logging.AddAttrs(r.Context(), "user_id", tac.Claims.UserID)
+
+			// permissions are checked per route, see the permissions package
+
+			// Add to context
+			ctx := context.WithValue(r.Context(), TokenContextKey, tac)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+func AuthorizeToken(authorization string, v *Verifier) (*TokenAndClaims, error) {
 	parts := strings.Split(authorization, " ")
 
 	if len(parts) != 2 || parts[0] != "Bearer" {
-		return nil, fmt.Errorf("invalid token format")
+		return nil, ErrInvalidFormat
 	}
 
-	claims, err := verifyJWT(parts[1])
+	claims, err := v.verifyJWT(parts[1])
 	if err != nil {
 		return nil, err
 	}
@@ -74,23 +165,28 @@ func GetAuthotizationToken(r *http.Request) (*TokenAndClaims, error) {
 	return nil, fmt.Errorf("Invalid token.")
 }
 
-func verifyJWT(tokenStr string) (*CustomJWTClaims, error) {
-	// Parse the token
-	token, err := jwt.ParseWithClaims(tokenStr, &CustomJWTClaims{}, func(token *jwt.Token) (interface{}, error) {
-		// TODO: we will need this in the future, for now skip
-		// if token.Method != jwt.SigningMethodHS256 {
-		// 	return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		// }
-		// Return the secret key to validate the token's signature
-		return []byte(os.Getenv("MIST_PY_API_JWT_SECRET_KEY")), nil
-	})
+// StringClaim returns the string claim name of a token that already passed
+// verification, or "" when the token does not carry it.
+func StringClaim(tac *TokenAndClaims, name string) string {
+	claims := jwt.MapClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(tac.Token, claims); err != nil {
+		return ""
+	}
+	value, _ := claims[name].(string)
+	return value
+}
+
+func (v *Verifier) verifyJWT(tokenStr string) (*CustomJWTClaims, error) {
+	// Parse the token, only accepting the allowed signing methods
+	token, err := jwt.ParseWithClaims(tokenStr, &CustomJWTClaims{}, v.keyFunc,
+		jwt.WithValidMethods(v.cfg.JWTAlgorithms))
 
 	if err != nil {
 		return nil, err
 	}
 
 	// Now validate the token's claims
-	claims, err := verifyJWTTokenClaims(token)
+	claims, err := verifyJWTTokenClaims(token, v.cfg)
 	if err != nil {
 		return nil, err
 	}
@@ -98,7 +194,29 @@ func verifyJWT(tokenStr string) (*CustomJWTClaims, error) {
 	return claims, nil
 }
 
-func verifyJWTTokenClaims(token *jwt.Token) (*CustomJWTClaims, error) {
+// keyFunc returns the keys that may have signed token. Asymmetric tokens are
+// matched by kid, or by algorithm when they carry none.
+func (v *Verifier) keyFunc(token *jwt.Token) (interface{}, error) {
+	if _, ok := token.Method.(*jwt.SigningMethodHMAC); ok {
+		if v.cfg.JWTSecretKey == "" {
+			return nil, ErrUnknownKey
+		}
+		return []byte(v.cfg.JWTSecretKey), nil
+	}
+
+	if v.keys == nil {
+		return nil, ErrUnknownKey
+	}
+
+	kid, _ := token.Header["kid"].(string)
+	keys := v.keys.lookup(kid, token.Method.Alg())
+	if len(keys) == 0 {
+		return nil, ErrUnknownKey
+	}
+	return jwt.VerificationKeySet{Keys: keys}, nil
+}
+
+func verifyJWTTokenClaims(token *jwt.Token, cfg config.AuthConfig) (*CustomJWTClaims, error) {
 	// Now validate the token's claims
 	claims, _ := token.Claims.(*CustomJWTClaims)
 
@@ -108,21 +226,43 @@ func verifyJWTTokenClaims(token *jwt.Token) (*CustomJWTClaims, error) {
 
 	// If "aud" is an array of strings, cast each element to string
 	for _, aud := range auds {
-		if aud == os.Getenv("MIST_PY_API_JWT_AUDIENCE") {
+		if aud == cfg.JWTAudience {
 			validAudience = true
 			break
 		}
 	}
 
 	if !validAudience {
-		return nil, fmt.Errorf("invalid audience claim")
+		return nil, ErrInvalidAudience
 	}
 
 	// Validate the issuer (iss) claim
-	if claims.Issuer != os.Getenv("MIST_PY_API_JWT_ISSUER") {
-		return nil, fmt.Errorf("invalid issuer claim")
+	if claims.Issuer != cfg.JWTIssuer {
+		return nil, ErrInvalidIssuer
 	}
 
 	// AuthJWTClaims
 	return claims, nil
 }
+
+// RequireAdminMiddleware only lets the listed users through, everyone else
+// gets a 403. It must run after AuthenticateMiddleware.
+func RequireAdminMiddleware(adminUserIDs []string) func(http.Handler) http.Handler {
+	admins := make(map[string]bool, len(adminUserIDs))
+	for _, id := range adminUserIDs {
+		admins[id] = true
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			tac, err := GetAuthotizationToken(r)
+			if err != nil || !admins[tac.Claims.UserID] {
+				logging.FromContext(r.Context()).Warn("Forbidden admin API call")
+				problem.Error(w, r, http.StatusForbidden, problem.PermissionDenied, "Forbidden.")
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -4,9 +4,9 @@ import (
 	"context"
 	"log"
 	"mistapi/src/auth"
+	"mistapi/src/problem"
 	"net/http"
 	"net/http/httptest"
-	"os"
 	"strings"
 	"testing"
 	"time"
@@ -30,9 +30,9 @@ func TestAuthenticateMiddleware(t *testing.T) {
 		{
 			name: "Success:valid_token_is_successful",
 			authHeader: bearerToken(createJwtToken(t, &CreateTokenParams{
-				iss:       os.Getenv("MIST_PY_API_JWT_ISSUER"),
-				aud:       []string{os.Getenv("MIST_PY_API_JWT_AUDIENCE")},
-				secretKey: os.Getenv("MIST_PY_API_JWT_SECRET_KEY"),
+				iss:       testAuthConfig.JWTIssuer,
+				aud:       []string{testAuthConfig.JWTAudience},
+				secretKey: testAuthConfig.JWTSecretKey,
 			})),
 			expectedStatus: http.StatusOK,
 		},
@@ -58,11 +58,15 @@ func TestAuthenticateMiddleware(t *testing.T) {
 			})
 
 			// ACT
-			handler := auth.AuthenticateMiddleware(next)
+			handler := auth.AuthenticateMiddleware(newTestVerifier(t, testAuthConfig))(next)
 			handler.ServeHTTP(rr, req)
 
 			// ASSERT
 			assert.Equal(t, tt.expectedStatus, rr.Code)
+			if tt.expectedStatus == http.StatusUnauthorized {
+				assert.Equal(t, problem.ContentType, rr.Header().Get("Content-Type"))
+				assert.Contains(t, rr.Body.String(), `"code":"unauthenticated"`)
+			}
 		})
 	}
 }
@@ -70,17 +74,18 @@ func TestAuthenticateMiddleware(t *testing.T) {
 func TestAuthorizeToken(t *testing.T) {
 	log.SetOutput(new(strings.Builder))
 	t.Parallel()
+	verifier := newTestVerifier(t, testAuthConfig)
 
 	t.Run("Success:valid_token_is_successful", func(t *testing.T) {
 		// ARRANGE
 		token := createJwtToken(t, &CreateTokenParams{
-			iss:       os.Getenv("MIST_PY_API_JWT_ISSUER"),
-			aud:       []string{os.Getenv("MIST_PY_API_JWT_AUDIENCE")},
-			secretKey: os.Getenv("MIST_PY_API_JWT_SECRET_KEY"),
+			iss:       testAuthConfig.JWTIssuer,
+			aud:       []string{testAuthConfig.JWTAudience},
+			secretKey: testAuthConfig.JWTSecretKey,
 		})
 
 		// ACT
-		tac, err := auth.AuthorizeToken(bearerToken(token))
+		tac, err := auth.AuthorizeToken(bearerToken(token), verifier)
 
 		// ASSERT
 		assert.Nil(t, err)
@@ -90,13 +95,13 @@ func TestAuthorizeToken(t *testing.T) {
 	t.Run("Error:token_with_invalid_audience_errors", func(t *testing.T) {
 		// ARRANGE
 		token := createJwtToken(t, &CreateTokenParams{
-			iss:       os.Getenv("MIST_PY_API_JWT_ISSUER"),
+			iss:       testAuthConfig.JWTIssuer,
 			aud:       []string{"invalid-audience"},
-			secretKey: os.Getenv("MIST_PY_API_JWT_SECRET_KEY"),
+			secretKey: testAuthConfig.JWTSecretKey,
 		})
 
 		// ACT
-		tac, err := auth.AuthorizeToken(bearerToken(token))
+		tac, err := auth.AuthorizeToken(bearerToken(token), verifier)
 
 		// ASSERT
 		assert.NotNil(t, err)
@@ -107,12 +112,12 @@ func TestAuthorizeToken(t *testing.T) {
 	t.Run("Error:token_with_invalid_issuer_errors", func(t *testing.T) {
 		// ARRANGE
 		token := createJwtToken(t, &CreateTokenParams{
-			aud:       []string{os.Getenv("MIST_PY_API_JWT_AUDIENCE")},
-			secretKey: os.Getenv("MIST_PY_API_JWT_SECRET_KEY"),
+			aud:       []string{testAuthConfig.JWTAudience},
+			secretKey: testAuthConfig.JWTSecretKey,
 		})
 
 		// ACT
-		tac, err := auth.AuthorizeToken(bearerToken(token))
+		tac, err := auth.AuthorizeToken(bearerToken(token), verifier)
 
 		// ASSERT
 		assert.NotNil(t, err)
@@ -123,13 +128,13 @@ func TestAuthorizeToken(t *testing.T) {
 	t.Run("Error:token_with_invalid_secret_key_errors", func(t *testing.T) {
 		// ARRANGE
 		token := createJwtToken(t, &CreateTokenParams{
-			iss:       os.Getenv("MIST_PY_API_JWT_ISSUER"),
-			aud:       []string{os.Getenv("MIST_PY_API_JWT_AUDIENCE")},
+			iss:       testAuthConfig.JWTIssuer,
+			aud:       []string{testAuthConfig.JWTAudience},
 			secretKey: "wrong-secret-key",
 		})
 
 		// ACT
-		tac, err := auth.AuthorizeToken(bearerToken(token))
+		tac, err := auth.AuthorizeToken(bearerToken(token), verifier)
 
 		// ASSERT
 		assert.NotNil(t, err)
@@ -142,7 +147,7 @@ func TestAuthorizeToken(t *testing.T) {
 		badToken := "bad_token"
 
 		// ACT
-		tac, err := auth.AuthorizeToken(badToken)
+		tac, err := auth.AuthorizeToken(badToken, verifier)
 
 		// ASSERT
 		assert.NotNil(t, err)
@@ -155,7 +160,7 @@ func TestAuthorizeToken(t *testing.T) {
 		missingToken := ""
 
 		// ACT
-		tac, err := auth.AuthorizeToken(missingToken)
+		tac, err := auth.AuthorizeToken(missingToken, verifier)
 
 		// ASSERT
 		assert.NotNil(t, err)
@@ -168,7 +173,7 @@ func TestAuthorizeToken(t *testing.T) {
 		token := "token_invalid"
 
 		// ACT
-		tac, err := auth.AuthorizeToken(bearerToken(token))
+		tac, err := auth.AuthorizeToken(bearerToken(token), verifier)
 
 		// ASSERT
 		assert.NotNil(t, err)
@@ -181,7 +186,7 @@ func TestAuthorizeToken(t *testing.T) {
 		token := ""
 
 		// ACT
-		tac, err := auth.AuthorizeToken(bearerToken(token))
+		tac, err := auth.AuthorizeToken(bearerToken(token), verifier)
 
 		// ASSERT
 		assert.NotNil(t, err)
@@ -192,16 +197,16 @@ func TestAuthorizeToken(t *testing.T) {
 	t.Run("Error:token_with_invalid_claims_format_for_audience_errors", func(t *testing.T) {
 		// ARRANGE
 		claims := &jwt.RegisteredClaims{
-			Issuer:    os.Getenv("MIST_PY_API_JWT_ISSUER"),
+			Issuer:    testAuthConfig.JWTIssuer,
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(1 * time.Hour)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 		}
 		token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-		tokenStr, err := token.SignedString([]byte(os.Getenv("MIST_PY_API_JWT_SECRET_KEY")))
+		tokenStr, err := token.SignedString([]byte(testAuthConfig.JWTSecretKey))
 		assert.Nil(t, err)
 
 		// ACT
-		tac, err := auth.AuthorizeToken(bearerToken(tokenStr))
+		tac, err := auth.AuthorizeToken(bearerToken(tokenStr), verifier)
 
 		// ASSERT
 		assert.NotNil(t, err)
@@ -260,3 +265,52 @@ func TestGetAuthotizationToken(t *testing.T) {
 		require.Nil(t, token, "Expected token to be nil when no token is set in context")
 	})
 }
+
+func TestFailureReason(t *testing.T) {
+	t.Parallel()
+	verifier := newTestVerifier(t, testAuthConfig)
+
+	expired := CreateTokenClaims(&CreateTokenParams{
+		iss: testAuthConfig.JWTIssuer,
+		aud: []string{testAuthConfig.JWTAudience},
+	})
+	expired.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-1 * time.Hour))
+	expiredToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, expired).SignedString(
+		[]byte(testAuthConfig.JWTSecretKey))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		authorization string
+		expected      string
+	}{
+		{"bad_format", "bad_token", "bad_format"},
+		{"malformed", bearerToken("token_invalid"), "malformed"},
+		{"expired", bearerToken(expiredToken), "expired"},
+		{"bad_audience", bearerToken(createJwtToken(t, &CreateTokenParams{
+			iss:       testAuthConfig.JWTIssuer,
+			aud:       []string{"invalid-audience"},
+			secretKey: testAuthConfig.JWTSecretKey,
+		})), "bad_audience"},
+		{"bad_issuer", bearerToken(createJwtToken(t, &CreateTokenParams{
+			aud:       []string{testAuthConfig.JWTAudience},
+			secretKey: testAuthConfig.JWTSecretKey,
+		})), "bad_issuer"},
+		{"bad_signature", bearerToken(createJwtToken(t, &CreateTokenParams{
+			iss:       testAuthConfig.JWTIssuer,
+			aud:       []string{testAuthConfig.JWTAudience},
+			secretKey: "wrong-secret-key",
+		})), "bad_signature"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// ACT
+			_, err := auth.AuthorizeToken(tt.authorization, verifier)
+
+			// ASSERT
+			require.Error(t, err)
+			assert.Equal(t, tt.expected, auth.FailureReason(err))
+		})
+	}
+}
@@ -0,0 +1,369 @@
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"mistapi/src/config"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// maxJWKSSize bounds the key set document read from a URL.
+const maxJWKSSize = 1 << 20
+
+// ecdsaAlgorithms maps a curve to the only algorithm allowed to use it.
+var ecdsaAlgorithms = map[string]string{
+	"P-256": "ES256",
+	"P-384": "ES384",
+	"P-521": "ES512",
+}
+
+// jwk is a verification key published in the key set.
+type jwk struct {
+	kid string
+	alg string
+	key jwt.VerificationKey
+	// lastSeen is when the key was last present in the key set.
+	lastSeen time.Time
+}
+
+// rawJWK holds the members of a JSON Web Key (RFC 7517) used for
+// verification.
+type rawJWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Crv string `json:"crv"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// keySet keeps the keys of a JWKS document, reloading it periodically and
+// when a token names an unknown kid. Keys dropped from the document stay
+// usable for cfg.GracePeriod, so the issuer can rotate keys while tokens
+// signed with the old one are still in flight.
+type keySet struct {
+	cfg    config.JWKSConfig
+	client *http.Client
+	now    func() time.Time
+
+	mu   sync.RWMutex
+	keys map[string]*jwk
+	// lastAttempt is when the last reload started, whether it succeeded or
+	// not, so an unreachable issuer is not retried for every token.
+	lastAttempt time.Time
+
+	// refreshMu serializes reloads, so a burst of unknown kids only fetches
+	// the document once.
+	refreshMu sync.Mutex
+	stop      chan struct{}
+	stopOnce  sync.Once
+}
+
+func newKeySet(cfg config.JWKSConfig) (*keySet, error) {
+	s := &keySet{
+		cfg:    cfg,
+		client: &http.Client{Timeout: 10 * time.Second},
+		now:    time.Now,
+		keys:   map[string]*jwk{},
+		stop:   make(chan struct{}),
+	}
+
+	if err := s.refresh(); err != nil {
+		return nil, err
+	}
+
+	go s.watch()
+	return s, nil
+}
+
+func (s *keySet) close() {
+	s.stopOnce.Do(func() { close(s.stop) })
+}
+
+func (s *keySet) watch() {
+	ticker := time.NewTicker(s.cfg.RefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+			// on failure the current keys are kept, the issuer may be down
+			if err := s.refresh(); err != nil {
+				slog.Warn("Refreshing JWKS failed", "error", err)
+			}
+		}
+	}
+}
+
+// lookup returns the keys that can verify a token signed with alg. A token
+// naming a kid only gets that key; an unknown kid triggers a rate limited
+// reload to pick up freshly published keys.
+func (s *keySet) lookup(kid, alg string) []jwt.VerificationKey {
+	if kid == "" {
+		return s.matching(alg)
+	}
+
+	if k := s.get(kid); k != nil {
+		return keysFor([]*jwk{k}, alg)
+	}
+
+	if s.refreshDue() {
+		if err := s.refreshIfDue(); err != nil {
+			slog.Warn("Refreshing JWKS for unknown kid failed", "kid", kid, "error", err)
+		}
+		if k := s.get(kid); k != nil {
+			return keysFor([]*jwk{k}, alg)
+		}
+	}
+	return nil
+}
+
+// refreshDue reports whether MinRefreshInterval passed since the last reload
+// attempt.
+func (s *keySet) refreshDue() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.now().Sub(s.lastAttempt) >= s.cfg.MinRefreshInterval
+}
+
+// refreshIfDue reloads the key set unless a reload was attempted less than
+// MinRefreshInterval ago, including one made while waiting for refreshMu.
+func (s *keySet) refreshIfDue() error {
+	s.refreshMu.Lock()
+	defer s.refreshMu.Unlock()
+
+	if !s.refreshDue() {
+		return nil
+	}
+	return s.reload()
+}
+
+func (s *keySet) get(kid string) *jwk {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.keys[kid]
+}
+
+func (s *keySet) matching(alg string) []jwt.VerificationKey {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	all := make([]*jwk, 0, len(s.keys))
+	for _, k := range s.keys {
+		all = append(all, k)
+	}
+	return keysFor(all, alg)
+}
+
+// keysFor filters keys down to the ones usable with alg.
+func keysFor(keys []*jwk, alg string) []jwt.VerificationKey {
+	var res []jwt.VerificationKey
+	for _, k := range keys {
+		if k.alg != "" && k.alg != alg {
+			continue
+		}
+
+		var ok bool
+		switch key := k.key.(type) {
+		case *rsa.PublicKey:
+			ok = strings.HasPrefix(alg, "RS") || strings.HasPrefix(alg, "PS")
+		case *ecdsa.PublicKey:
+			ok = ecdsaAlgorithms[key.Curve.Params().Name] == alg
+		case ed25519.PublicKey:
+			ok = alg == "EdDSA"
+		}
+		if ok {
+			res = append(res, k.key)
+		}
+	}
+	return res
+}
+
+func (s *keySet) refresh() error {
+	s.refreshMu.Lock()
+	defer s.refreshMu.Unlock()
+
+	return s.reload()
+}
+
+// reload fetches and applies the key set. It is called with refreshMu held.
+func (s *keySet) reload() error {
+	s.mu.Lock()
+	s.lastAttempt = s.now()
+	s.mu.Unlock()
+
+	body, err := s.load()
+	if err != nil {
+		return err
+	}
+
+	parsed, err := parseJWKS(body)
+	if err != nil {
+		return err
+	}
+
+	now := s.now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, k := range parsed {
+		k.lastSeen = now
+		s.keys[k.kid] = k
+	}
+	for kid, k := range s.keys {
+		if now.Sub(k.lastSeen) > s.cfg.GracePeriod {
+			delete(s.keys, kid)
+		}
+	}
+
+	return nil
+}
+
+func (s *keySet) load() ([]byte, error) {
+	if s.cfg.File != "" {
+		body, err := os.ReadFile(s.cfg.File)
+		if err != nil {
+			return nil, fmt.Errorf("reading JWKS file: %w", err)
+		}
+		return body, nil
+	}
+
+	res, err := s.client.Get(s.cfg.URL)
+	if err != nil {
+		return nil, fmt.Errorf("fetching JWKS: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching JWKS: unexpected status %s", res.Status)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, maxJWKSSize))
+	if err != nil {
+		return nil, fmt.Errorf("reading JWKS: %w", err)
+	}
+	return body, nil
+}
+
+// parseJWKS returns the verification keys of a JWKS document. Encryption
+// keys and unsupported key types are skipped, a document without a single
+// usable key is an error.
+func parseJWKS(body []byte) ([]*jwk, error) {
+	var doc struct {
+		Keys []rawJWK `json:"keys"`
+	}
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return nil, fmt.Errorf("parsing JWKS: %w", err)
+	}
+
+	var keys []*jwk
+	for i, raw := range doc.Keys {
+		if raw.Use != "" && raw.Use != "sig" {
+			continue
+		}
+
+		key, err := parseJWK(raw)
+		if err != nil {
+			slog.Warn("Skipping JWKS key", "kid", raw.Kid, "error", err)
+			continue
+		}
+
+		kid := raw.Kid
+		if kid == "" {
+			// keys without kid can only be matched by algorithm
+			kid = fmt.Sprintf("#%d", i)
+		}
+		keys = append(keys, &jwk{kid: kid, alg: raw.Alg, key: key})
+	}
+
+	if len(keys) == 0 {
+		return nil, errors.New("parsing JWKS: no usable signing keys")
+	}
+	return keys, nil
+}
+
+func parseJWK(raw rawJWK) (jwt.VerificationKey, error) {
+	switch raw.Kty {
+	case "RSA":
+		n, err := decodeBigInt(raw.N)
+		if err != nil {
+			return nil, fmt.Errorf("invalid n: %w", err)
+		}
+		e, err := decodeBigInt(raw.E)
+		if err != nil || !e.IsInt64() {
+			return nil, errors.New("invalid e")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+
+	case "EC":
+		var curve elliptic.Curve
+		switch raw.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", raw.Crv)
+		}
+		x, err := decodeBigInt(raw.X)
+		if err != nil {
+			return nil, fmt.Errorf("invalid x: %w", err)
+		}
+		y, err := decodeBigInt(raw.Y)
+		if err != nil {
+			return nil, fmt.Errorf("invalid y: %w", err)
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, errors.New("point is not on the curve")
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+
+	case "OKP":
+		if raw.Crv != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve %q", raw.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(raw.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid x")
+		}
+		return ed25519.PublicKey(x), nil
+
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", raw.Kty)
+	}
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) == 0 {
+		return nil, errors.New("empty value")
+	}
+	return new(big.Int).SetBytes(b), nil
+}
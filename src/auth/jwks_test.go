@@ -0,0 +1,239 @@
+package auth_test
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"mistapi/src/auth"
+	"mistapi/src/config"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func b64(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// publicJWK renders the public part of key as a JSON Web Key.
+func publicJWK(t *testing.T, kid string, key crypto.Signer) map[string]string {
+	switch pub := key.Public().(type) {
+	case *rsa.PublicKey:
+		return map[string]string{"kty": "RSA", "kid": kid, "n": b64(pub.N.Bytes()),
+			"e": b64(big.NewInt(int64(pub.E)).Bytes())}
+	case *ecdsa.PublicKey:
+		size := (pub.Curve.Params().BitSize + 7) / 8
+		return map[string]string{"kty": "EC", "kid": kid, "crv": pub.Curve.Params().Name,
+			"x": b64(pub.X.FillBytes(make([]byte, size))), "y": b64(pub.Y.FillBytes(make([]byte, size)))}
+	case ed25519.PublicKey:
+		return map[string]string{"kty": "OKP", "kid": kid, "crv": "Ed25519", "x": b64(pub)}
+	}
+	t.Fatalf("unsupported key type %T", key)
+	return nil
+}
+
+func jwksDocument(t *testing.T, keys ...map[string]string) []byte {
+	body, err := json.Marshal(map[string]any{"keys": keys})
+	require.NoError(t, err)
+	return body
+}
+
+func writeJWKS(t *testing.T, path string, keys ...map[string]string) {
+	require.NoError(t, os.WriteFile(path, jwksDocument(t, keys...), 0o600))
+}
+
+func signToken(t *testing.T, method jwt.SigningMethod, kid string, key any) string {
+	token := jwt.NewWithClaims(method, CreateTokenClaims(&CreateTokenParams{
+		iss: testAuthConfig.JWTIssuer,
+		aud: []string{testAuthConfig.JWTAudience},
+	}))
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	tokenStr, err := token.SignedString(key)
+	require.NoError(t, err)
+	return tokenStr
+}
+
+func jwksAuthConfig(jwks config.JWKSConfig, algorithms ...string) config.AuthConfig {
+	cfg := testAuthConfig
+	cfg.JWTAlgorithms = algorithms
+	jwks.RefreshInterval = config.Default().Auth.JWKS.RefreshInterval
+	cfg.JWKS = jwks
+	return cfg
+}
+
+func TestVerifierJWKS(t *testing.T) {
+	t.Parallel()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	t.Run("Success:asymmetric_algorithms_are_verified", func(t *testing.T) {
+		// ARRANGE
+		path := filepath.Join(t.TempDir(), "jwks.json")
+		writeJWKS(t, path, publicJWK(t, "rsa", rsaKey), publicJWK(t, "ec", ecKey), publicJWK(t, "ed", edKey))
+		verifier := newTestVerifier(t, jwksAuthConfig(config.JWKSConfig{File: path}, "RS256", "ES256", "EdDSA"))
+
+		tokens := map[string]string{
+			"RS256":        signToken(t, jwt.SigningMethodRS256, "rsa", rsaKey),
+			"ES256":        signToken(t, jwt.SigningMethodES256, "ec", ecKey),
+			"EdDSA":        signToken(t, jwt.SigningMethodEdDSA, "ed", edKey),
+			"ES256_no_kid": signToken(t, jwt.SigningMethodES256, "", ecKey),
+		}
+
+		for name, token := range tokens {
+			// ACT
+			_, err := auth.AuthorizeToken(bearerToken(token), verifier)
+
+			// ASSERT
+			assert.NoError(t, err, name)
+		}
+	})
+
+	t.Run("Success:keys_are_fetched_from_a_url", func(t *testing.T) {
+		// ARRANGE
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write(jwksDocument(t, publicJWK(t, "ed", edKey)))
+		}))
+		t.Cleanup(srv.Close)
+		verifier := newTestVerifier(t, jwksAuthConfig(config.JWKSConfig{URL: srv.URL}, "EdDSA"))
+
+		// ACT
+		_, err := auth.AuthorizeToken(bearerToken(signToken(t, jwt.SigningMethodEdDSA, "ed", edKey)), verifier)
+
+		// ASSERT
+		assert.NoError(t, err)
+	})
+
+	t.Run("Error:algorithms_outside_the_allow_list_are_rejected", func(t *testing.T) {
+		// ARRANGE
+		path := filepath.Join(t.TempDir(), "jwks.json")
+		writeJWKS(t, path, publicJWK(t, "rsa", rsaKey))
+		verifier := newTestVerifier(t, jwksAuthConfig(config.JWKSConfig{File: path}, "RS256"))
+
+		tokens := map[string]string{
+			"HS256": signToken(t, jwt.SigningMethodHS256, "", []byte(testAuthConfig.JWTSecretKey)),
+			"none":  signToken(t, jwt.SigningMethodNone, "", jwt.UnsafeAllowNoneSignatureType),
+		}
+
+		for name, token := range tokens {
+			// ACT
+			_, err := auth.AuthorizeToken(bearerToken(token), verifier)
+
+			// ASSERT
+			require.Error(t, err, name)
+			assert.ErrorIs(t, err, jwt.ErrTokenSignatureInvalid, name)
+		}
+	})
+
+	t.Run("Error:unknown_kid_is_rejected", func(t *testing.T) {
+		// ARRANGE
+		path := filepath.Join(t.TempDir(), "jwks.json")
+		writeJWKS(t, path, publicJWK(t, "rsa", rsaKey))
+		verifier := newTestVerifier(t, jwksAuthConfig(config.JWKSConfig{File: path}, "RS256"))
+
+		// ACT
+		_, err := auth.AuthorizeToken(bearerToken(signToken(t, jwt.SigningMethodRS256, "other", rsaKey)), verifier)
+
+		// ASSERT
+		assert.ErrorIs(t, err, auth.ErrUnknownKey)
+		assert.Equal(t, "unknown_key", auth.FailureReason(err))
+	})
+
+	t.Run("Error:failed_reloads_for_unknown_kids_are_rate_limited", func(t *testing.T) {
+		// ARRANGE
+		var fetches atomic.Int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if fetches.Add(1) > 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.Write(jwksDocument(t, publicJWK(t, "ed", edKey)))
+		}))
+		t.Cleanup(srv.Close)
+		verifier := newTestVerifier(t, jwksAuthConfig(
+			config.JWKSConfig{URL: srv.URL, MinRefreshInterval: 50 * time.Millisecond}, "EdDSA"))
+		time.Sleep(60 * time.Millisecond)
+
+		// ACT
+		for _, kid := range []string{"a", "b", "c"} {
+			_, err := auth.AuthorizeToken(bearerToken(signToken(t, jwt.SigningMethodEdDSA, kid, edKey)), verifier)
+			assert.ErrorIs(t, err, auth.ErrUnknownKey)
+		}
+
+		// ASSERT
+		assert.Equal(t, int32(2), fetches.Load())
+	})
+
+	t.Run("Success:rotated_keys_are_picked_up_and_old_ones_kept_for_the_grace_period", func(t *testing.T) {
+		// ARRANGE
+		newKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+
+		path := filepath.Join(t.TempDir(), "jwks.json")
+		writeJWKS(t, path, publicJWK(t, "old", rsaKey))
+		verifier := newTestVerifier(t, jwksAuthConfig(
+			config.JWKSConfig{File: path, GracePeriod: config.Default().Auth.JWKS.GracePeriod}, "RS256"))
+
+		// ACT
+		writeJWKS(t, path, publicJWK(t, "new", newKey))
+		_, newErr := auth.AuthorizeToken(bearerToken(signToken(t, jwt.SigningMethodRS256, "new", newKey)), verifier)
+		_, oldErr := auth.AuthorizeToken(bearerToken(signToken(t, jwt.SigningMethodRS256, "old", rsaKey)), verifier)
+
+		// ASSERT
+		assert.NoError(t, newErr)
+		assert.NoError(t, oldErr)
+	})
+
+	t.Run("Error:removed_keys_expire_without_grace_period", func(t *testing.T) {
+		// ARRANGE
+		newKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+
+		path := filepath.Join(t.TempDir(), "jwks.json")
+		writeJWKS(t, path, publicJWK(t, "old", rsaKey))
+		verifier := newTestVerifier(t, jwksAuthConfig(config.JWKSConfig{File: path}, "RS256"))
+
+		// ACT
+		writeJWKS(t, path, publicJWK(t, "new", newKey))
+		_, newErr := auth.AuthorizeToken(bearerToken(signToken(t, jwt.SigningMethodRS256, "new", newKey)), verifier)
+		_, oldErr := auth.AuthorizeToken(bearerToken(signToken(t, jwt.SigningMethodRS256, "old", rsaKey)), verifier)
+
+		// ASSERT
+		assert.NoError(t, newErr)
+		assert.ErrorIs(t, oldErr, auth.ErrUnknownKey)
+	})
+
+	t.Run("Error:invalid_jwks_fails_verifier_creation", func(t *testing.T) {
+		// ARRANGE
+		path := filepath.Join(t.TempDir(), "jwks.json")
+		require.NoError(t, os.WriteFile(path, []byte(`{"keys": [{"kty": "RSA", "n": "!"}]}`), 0o600))
+
+		// ACT
+		_, err := auth.NewVerifier(jwksAuthConfig(config.JWKSConfig{File: path}, "RS256"))
+
+		// ASSERT
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no usable signing keys")
+	})
+}
@@ -0,0 +1,226 @@
+package auth_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"mistapi/src/auth"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyValueStores(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success:memory_entries_expire_after_their_ttl", func(t *testing.T) {
+		// ARRANGE
+		store := auth.NewMemoryStore()
+		require.NoError(t, store.Set(ctx, "short", "1", 10*time.Millisecond))
+		require.NoError(t, store.Set(ctx, "long", "2", time.Hour))
+
+		// ACT
+		time.Sleep(20 * time.Millisecond)
+		_, shortOk, _ := store.Get(ctx, "short")
+		value, longOk, _ := store.Get(ctx, "long")
+
+		// ASSERT
+		assert.False(t, shortOk)
+		assert.True(t, longOk)
+		assert.Equal(t, "2", value)
+	})
+
+	t.Run("Success:compare_and_set_only_replaces_the_expected_value", func(t *testing.T) {
+		// ARRANGE
+		store := auth.NewMemoryStore()
+
+		// ACT
+		created, _ := store.CompareAndSet(ctx, "key", "", "1", time.Hour)
+		stale, _ := store.CompareAndSet(ctx, "key", "", "2", time.Hour)
+		replaced, _ := store.CompareAndSet(ctx, "key", "1", "3", time.Hour)
+		value, _, _ := store.Get(ctx, "key")
+
+		// ASSERT
+		assert.True(t, created)
+		assert.False(t, stale)
+		assert.True(t, replaced)
+		assert.Equal(t, "3", value)
+	})
+
+	t.Run("Success:file_entries_survive_a_reload", func(t *testing.T) {
+		// ARRANGE
+		path := filepath.Join(t.TempDir(), "revocations.json")
+		store, err := auth.NewFileStore(path)
+		require.NoError(t, err)
+		require.NoError(t, store.Set(ctx, "key", "value", time.Hour))
+
+		// ACT
+		reloaded, err := auth.NewFileStore(path)
+		require.NoError(t, err)
+		value, ok, err := reloaded.Get(ctx, "key")
+
+		// ASSERT
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, "value", value)
+	})
+}
+
+func TestRevocations(t *testing.T) {
+	ctx := context.Background()
+
+	claims := func(jti, userID string, issuedAt time.Time) *auth.CustomJWTClaims {
+		return &auth.CustomJWTClaims{
+			RegisteredClaims: jwt.RegisteredClaims{ID: jti, IssuedAt: jwt.NewNumericDate(issuedAt)},
+			UserID:           userID,
+		}
+	}
+
+	t.Run("Error:revoked_jti_is_rejected", func(t *testing.T) {
+		// ARRANGE
+		revocations := auth.NewRevocations(auth.NewMemoryStore(), time.Hour)
+		require.NoError(t, revocations.RevokeToken(ctx, "jti-1"))
+
+		// ACT
+		revokedErr := revocations.Check(ctx, claims("jti-1", "user", time.Now()))
+		otherErr := revocations.Check(ctx, claims("jti-2", "user", time.Now()))
+
+		// ASSERT
+		assert.ErrorIs(t, revokedErr, auth.ErrTokenRevoked)
+		assert.NoError(t, otherErr)
+	})
+
+	t.Run("Error:user_tokens_issued_before_the_cutoff_are_rejected", func(t *testing.T) {
+		// ARRANGE
+		revocations := auth.NewRevocations(auth.NewMemoryStore(), time.Hour)
+		cutoff := time.Now()
+		require.NoError(t, revocations.RevokeUser(ctx, "user", cutoff))
+
+		// ACT
+		oldErr := revocations.Check(ctx, claims("", "user", cutoff.Add(-time.Minute)))
+		newErr := revocations.Check(ctx, claims("", "user", cutoff.Add(time.Minute)))
+		otherUserErr := revocations.Check(ctx, claims("", "other", cutoff.Add(-time.Minute)))
+
+		// ASSERT
+		assert.ErrorIs(t, oldErr, auth.ErrTokenRevoked)
+		assert.NoError(t, newErr)
+		assert.NoError(t, otherUserErr)
+	})
+
+	t.Run("Success:earlier_cutoff_does_not_replace_a_later_one", func(t *testing.T) {
+		// ARRANGE
+		revocations := auth.NewRevocations(auth.NewMemoryStore(), time.Hour)
+		cutoff := time.Now()
+		require.NoError(t, revocations.RevokeUser(ctx, "user", cutoff))
+
+		// ACT
+		require.NoError(t, revocations.RevokeUser(ctx, "user", cutoff.Add(-30*time.Minute)))
+		err := revocations.Check(ctx, claims("", "user", cutoff.Add(-time.Minute)))
+
+		// ASSERT
+		assert.ErrorIs(t, err, auth.ErrTokenRevoked)
+	})
+
+	t.Run("Success:tokens_issued_in_the_second_of_the_cutoff_are_accepted", func(t *testing.T) {
+		// ARRANGE
+		revocations := auth.NewRevocations(auth.NewMemoryStore(), time.Hour)
+		second := time.Now().Truncate(time.Second)
+		require.NoError(t, revocations.RevokeUser(ctx, "user", second.Add(700*time.Millisecond)))
+
+		// ACT
+		sameErr := revocations.Check(ctx, claims("", "user", second.Add(800*time.Millisecond)))
+		beforeErr := revocations.Check(ctx, claims("", "user", second.Add(-time.Second)))
+
+		// ASSERT
+		assert.NoError(t, sameErr)
+		assert.ErrorIs(t, beforeErr, auth.ErrTokenRevoked)
+	})
+
+	t.Run("Success:concurrent_revocations_keep_the_latest_cutoff", func(t *testing.T) {
+		// ARRANGE
+		revocations := auth.NewRevocations(auth.NewMemoryStore(), time.Hour)
+		latest := time.Now().Truncate(time.Second)
+
+		// ACT
+		var wg sync.WaitGroup
+		for i := range 20 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				assert.NoError(t, revocations.RevokeUser(ctx, "user", latest.Add(-time.Duration(i)*time.Second)))
+			}()
+		}
+		wg.Wait()
+		err := revocations.Check(ctx, claims("", "user", latest.Add(-time.Second)))
+
+		// ASSERT
+		assert.ErrorIs(t, err, auth.ErrTokenRevoked)
+	})
+}
+
+func TestAuthenticateMiddlewareRevocation(t *testing.T) {
+	// ARRANGE
+	verifier := newTestVerifier(t, testAuthConfig)
+	tokenClaims := CreateTokenClaims(&CreateTokenParams{
+		iss:    testAuthConfig.JWTIssuer,
+		aud:    []string{testAuthConfig.JWTAudience},
+		userId: "user",
+	})
+	tokenClaims.ID = "jti-1"
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, tokenClaims).SignedString(
+		[]byte(testAuthConfig.JWTSecretKey))
+	require.NoError(t, err)
+
+	require.NoError(t, verifier.Revocations().RevokeToken(context.Background(), "jti-1"))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Authorization", bearerToken(token))
+	rr := httptest.NewRecorder()
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("revoked token reached the handler")
+	})
+
+	// ACT
+	auth.AuthenticateMiddleware(verifier)(next).ServeHTTP(rr, req)
+
+	// ASSERT
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	assert.Equal(t, "revoked", auth.FailureReason(auth.ErrTokenRevoked))
+}
+
+func TestRequireAdminMiddleware(t *testing.T) {
+	tests := []struct {
+		name           string
+		userID         string
+		expectedStatus int
+	}{
+		{"Success:admin_is_let_through", "admin", http.StatusOK},
+		{"Error:other_users_are_forbidden", "user", http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// ARRANGE
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			req = req.WithContext(context.WithValue(req.Context(), auth.TokenContextKey, &auth.TokenAndClaims{
+				Claims: &auth.CustomJWTClaims{UserID: tt.userID},
+			}))
+			rr := httptest.NewRecorder()
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})
+
+			// ACT
+			auth.RequireAdminMiddleware([]string{"admin"})(next).ServeHTTP(rr, req)
+
+			// ASSERT
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+		})
+	}
+}
@@ -0,0 +1,176 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"mistapi/src/config"
+)
+
+// KeyValueStore is the storage used for revocations. Its semantics match
+// Redis SET with an expiry, GET and a WATCH/MULTI transaction, so a shared
+// Redis can be plugged in to share revocations between replicas.
+type KeyValueStore interface {
+	// Set stores value under key until ttl elapses.
+	Set(ctx context.Context, key, value string, ttl time.Duration) error
+	// Get returns the value of key, ok is false when it is missing or expired.
+	Get(ctx context.Context, key string) (value string, ok bool, err error)
+	// CompareAndSet stores value under key until ttl elapses, provided key
+	// still holds old; an empty old stands for a missing or expired key. ok
+	// is false when key holds something else.
+	CompareAndSet(ctx context.Context, key, old, value string, ttl time.Duration) (ok bool, err error)
+}
+
+// NewKeyValueStore creates the store selected in cfg.
+func NewKeyValueStore(cfg config.RevocationConfig) (KeyValueStore, error) {
+	switch cfg.Store {
+	case "", "memory":
+		return NewMemoryStore(), nil
+	case "file":
+		return NewFileStore(cfg.File)
+	default:
+		return nil, fmt.Errorf("unsupported revocation store %q", cfg.Store)
+	}
+}
+
+type storeEntry struct {
+	Value     string    `json:"value"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// MemoryStore is an in-process KeyValueStore. Expired entries are dropped on
+// the next write.
+type MemoryStore struct {
+	mu      sync.Mutex
+	entries map[string]storeEntry
+	now     func() time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{entries: map[string]storeEntry{}, now: time.Now}
+}
+
+func (s *MemoryStore) Set(ctx context.Context, key, value string, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.set(key, value, ttl)
+	return nil
+}
+
+func (s *MemoryStore) Get(ctx context.Context, key string) (string, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	value, ok := s.get(key)
+	return value, ok, nil
+}
+
+func (s *MemoryStore) CompareAndSet(ctx context.Context, key, old, value string, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if current, _ := s.get(key); current != old {
+		return false, nil
+	}
+	s.set(key, value, ttl)
+	return true, nil
+}
+
+// get must be called with mu held.
+func (s *MemoryStore) get(key string) (string, bool) {
+	e, ok := s.entries[key]
+	if !ok || !s.now().Before(e.ExpiresAt) {
+		return "", false
+	}
+	return e.Value, true
+}
+
+// set must be called with mu held.
+func (s *MemoryStore) set(key, value string, ttl time.Duration) {
+	now := s.now()
+	for k, e := range s.entries {
+		if !now.Before(e.ExpiresAt) {
+			delete(s.entries, k)
+		}
+	}
+	s.entries[key] = storeEntry{Value: value, ExpiresAt: now.Add(ttl)}
+}
+
+// FileStore is a MemoryStore persisted to a JSON file on every write, so
+// revocations survive restarts of a single instance.
+type FileStore struct {
+	MemoryStore
+	path string
+}
+
+// NewFileStore loads the entries saved at path, a missing file is an empty
+// store.
+func NewFileStore(path string) (*FileStore, error) {
+	s := &FileStore{MemoryStore: *NewMemoryStore(), path: path}
+
+	content, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading revocation file: %w", err)
+	}
+
+	if err := json.Unmarshal(content, &s.entries); err != nil {
+		return nil, fmt.Errorf("parsing revocation file %s: %w", path, err)
+	}
+	return s, nil
+}
+
+func (s *FileStore) Set(ctx context.Context, key, value string, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.set(key, value, ttl)
+	return s.save()
+}
+
+func (s *FileStore) CompareAndSet(ctx context.Context, key, old, value string, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if current, _ := s.get(key); current != old {
+		return false, nil
+	}
+	s.set(key, value, ttl)
+	return true, s.save()
+}
+
+// save atomically replaces the file with the current entries, it must be
+// called with mu held.
+func (s *FileStore) save() error {
+	content, err := json.Marshal(s.entries)
+	if err != nil {
+		return fmt.Errorf("encoding revocations: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*")
+	if err != nil {
+		return fmt.Errorf("writing revocation file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(content); err != nil {
+		tmp.Close()
+		return fmt.Errorf("writing revocation file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("writing revocation file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("writing revocation file: %w", err)
+	}
+	return nil
+}
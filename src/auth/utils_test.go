@@ -3,12 +3,29 @@ package auth_test
 import (
 	"fmt"
 	"mistapi/src/auth"
+	"mistapi/src/config"
 	"testing"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 )
 
+var testAuthConfig = config.AuthConfig{
+	JWTSecretKey:  "test-secret-key",
+	JWTAudience:   "test-audience",
+	JWTIssuer:     "test-issuer",
+	JWTAlgorithms: []string{"HS256"},
+}
+
+func newTestVerifier(t *testing.T, cfg config.AuthConfig) *auth.Verifier {
+	v, err := auth.NewVerifier(cfg)
+	if err != nil {
+		t.Fatalf("error creating the verifier %v", err)
+	}
+	t.Cleanup(v.Close)
+	return v
+}
+
 type CreateTokenParams struct {
 	iss       string
 	aud       []string
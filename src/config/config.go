@@ -0,0 +1,583 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// ConfigFileEnv points to an optional YAML or TOML file. Values set through
+// environment variables always take precedence over the ones in the file.
+const ConfigFileEnv = "MIST_API_CONFIG_FILE"
+
+// DefaultBackendTimeout is the budget of backend calls when none is configured.
+const DefaultBackendTimeout = 5 * time.Second
+
+type Config struct {
+	App     AppConfig     `yaml:"app" toml:"app"`
+	Backend BackendConfig `yaml:"backend" toml:"backend"`
+	Auth    AuthConfig    `yaml:"auth" toml:"auth"`
+	Log     LogConfig     `yaml:"log" toml:"log"`
+	Tracing TracingConfig `yaml:"tracing" toml:"tracing"`
+	Metrics MetricsConfig `yaml:"metrics" toml:"metrics"`
+	Health  HealthConfig  `yaml:"health" toml:"health"`
+}
+
+type AppConfig struct {
+	Port string `yaml:"port" toml:"port"`
+	// ShutdownTimeout bounds how long in-flight requests are allowed to finish
+	// once the server stops accepting new connections.
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout" toml:"shutdown_timeout"`
+	// TrustRequestID reuses the X-Request-Id sent by the caller instead of
+	// generating a new one. Only enable it behind a proxy that sets it.
+	TrustRequestID bool `yaml:"trust_request_id" toml:"trust_request_id"`
+	// ShutdownDelay keeps serving after readiness flips to not-ready on
+	// shutdown, giving load balancers time to stop routing traffic here.
+	ShutdownDelay time.Duration `yaml:"shutdown_delay" toml:"shutdown_delay"`
+	// CursorSecret signs the pagination cursors handed to clients. Replicas
+	// must share it; when empty a random one is used and cursors stop
+	// working across restarts.
+	CursorSecret string `yaml:"cursor_secret" toml:"cursor_secret"`
+	// MaxBodySize is the largest request body accepted, in bytes.
+	MaxBodySize int `yaml:"max_body_size" toml:"max_body_size"`
+}
+
+type BackendConfig struct {
+	URL string `yaml:"url" toml:"url"`
+	// Timeout is the default budget for the backend calls made by a request.
+	Timeout time.Duration `yaml:"timeout" toml:"timeout"`
+	// MaxTimeout caps the budget a client can ask for with X-Request-Timeout.
+	MaxTimeout time.Duration `yaml:"max_timeout" toml:"max_timeout"`
+	// RouteTimeouts overrides Timeout per route, keyed by "METHOD /route/{pattern}".
+	RouteTimeouts map[string]time.Duration `yaml:"route_timeouts" toml:"route_timeouts"`
+	TLS           BackendTLSConfig         `yaml:"tls" toml:"tls"`
+	Retry         RetryConfig              `yaml:"retry" toml:"retry"`
+	Breaker       BreakerConfig            `yaml:"breaker" toml:"breaker"`
+}
+
+// RetryConfig is the retry policy of the idempotent backend RPCs. Mutating
+// RPCs are never retried.
+type RetryConfig struct {
+	// MaxAttempts includes the original call, 1 disables retries. gRPC caps
+	// it at 5.
+	MaxAttempts int `yaml:"max_attempts" toml:"max_attempts"`
+	// InitialBackoff, MaxBackoff and BackoffMultiplier shape the exponential
+	// backoff. gRPC randomizes every delay between zero and the current
+	// backoff.
+	InitialBackoff    time.Duration `yaml:"initial_backoff" toml:"initial_backoff"`
+	MaxBackoff        time.Duration `yaml:"max_backoff" toml:"max_backoff"`
+	BackoffMultiplier float64       `yaml:"backoff_multiplier" toml:"backoff_multiplier"`
+}
+
+// BreakerConfig controls the circuit breaker kept per backend service.
+type BreakerConfig struct {
+	// FailureThreshold is the number of consecutive failed calls that open
+	// the circuit, 0 disables the breaker.
+	FailureThreshold int `yaml:"failure_threshold" toml:"failure_threshold"`
+	// OpenTimeout is how long calls fail fast before a probe call is let
+	// through.
+	OpenTimeout time.Duration `yaml:"open_timeout" toml:"open_timeout"`
+}
+
+type BackendTLSConfig struct {
+	// Insecure disables transport security. Development only.
+	Insecure bool `yaml:"insecure" toml:"insecure"`
+	// CAFile is a PEM bundle used to verify the backend, system roots are
+	// used when empty.
+	CAFile string `yaml:"ca_file" toml:"ca_file"`
+	// CertFile and KeyFile hold the client certificate used for mutual TLS.
+	CertFile   string `yaml:"cert_file" toml:"cert_file"`
+	KeyFile    string `yaml:"key_file" toml:"key_file"`
+	ServerName string `yaml:"server_name" toml:"server_name"`
+	// ReloadInterval is how often the files are checked for rotation.
+	ReloadInterval time.Duration `yaml:"reload_interval" toml:"reload_interval"`
+}
+
+type AuthConfig struct {
+	// JWTSecretKey verifies HMAC signed tokens. Required when an HS algorithm
+	// is allowed.
+	JWTSecretKey string `yaml:"jwt_secret_key" toml:"jwt_secret_key"`
+	JWTAudience  string `yaml:"jwt_audience" toml:"jwt_audience"`
+	JWTIssuer    string `yaml:"jwt_issuer" toml:"jwt_issuer"`
+	// JWTAlgorithms is the allow-list of signing algorithms, tokens signed
+	// with anything else are rejected.
+	JWTAlgorithms []string   `yaml:"jwt_algorithms" toml:"jwt_algorithms"`
+	JWKS          JWKSConfig `yaml:"jwks" toml:"jwks"`
+	// UsernameClaim is the token claim holding the username of the user.
+	UsernameClaim string             `yaml:"username_claim" toml:"username_claim"`
+	Revocation    RevocationConfig   `yaml:"revocation" toml:"revocation"`
+	Provisioning  ProvisioningConfig `yaml:"provisioning" toml:"provisioning"`
+}
+
+type ProvisioningConfig struct {
+	// Enabled creates the appuser of a token on its first authenticated
+	// request, so new sign-ups can use the API right away.
+	Enabled bool `yaml:"enabled" toml:"enabled"`
+	// CacheTTL is how long a user is remembered as existing before the next
+	// request checks again.
+	CacheTTL time.Duration `yaml:"cache_ttl" toml:"cache_ttl"`
+}
+
+type RevocationConfig struct {
+	// Store is memory or file. A memory store is lost on restart and not
+	// shared between replicas.
+	Store string `yaml:"store" toml:"store"`
+	// File persists the revocations when Store is file.
+	File string `yaml:"file" toml:"file"`
+	// MaxTokenLifetime is how long revocations are kept, no token issued
+	// before a revocation outlives it.
+	MaxTokenLifetime time.Duration `yaml:"max_token_lifetime" toml:"max_token_lifetime"`
+	// AdminUserIDs are the users allowed to call the admin endpoints.
+	AdminUserIDs []string `yaml:"admin_user_ids" toml:"admin_user_ids"`
+}
+
+// JWKSConfig locates the public keys of the asymmetric algorithms. Exactly
+// one of URL and File is used.
+type JWKSConfig struct {
+	URL  string `yaml:"url" toml:"url"`
+	File string `yaml:"file" toml:"file"`
+	// RefreshInterval is how often the key set is reloaded.
+	RefreshInterval time.Duration `yaml:"refresh_interval" toml:"refresh_interval"`
+	// MinRefreshInterval rate limits the reloads triggered by tokens signed
+	// with an unknown kid.
+	MinRefreshInterval time.Duration `yaml:"min_refresh_interval" toml:"min_refresh_interval"`
+	// GracePeriod keeps accepting a key after it disappeared from the key set,
+	// so tokens signed just before a rotation stay valid.
+	GracePeriod time.Duration `yaml:"grace_period" toml:"grace_period"`
+}
+
+type LogConfig struct {
+	// Format is either "json" or "text".
+	Format string `yaml:"format" toml:"format"`
+	// Level is one of debug, info, warn or error.
+	Level string `yaml:"level" toml:"level"`
+}
+
+type TracingConfig struct {
+	// Exporter is one of none, stdout or otlp.
+	Exporter    string `yaml:"exporter" toml:"exporter"`
+	ServiceName string `yaml:"service_name" toml:"service_name"`
+	// OTLPEndpoint is the host:port of the OTLP gRPC collector.
+	OTLPEndpoint string `yaml:"otlp_endpoint" toml:"otlp_endpoint"`
+	OTLPInsecure bool   `yaml:"otlp_insecure" toml:"otlp_insecure"`
+	// SampleRatio is the fraction of new traces that are recorded.
+	SampleRatio float64 `yaml:"sample_ratio" toml:"sample_ratio"`
+}
+
+type MetricsConfig struct {
+	// Enabled exposes /metrics. It is served on Port when set, otherwise on the
+	// main listener, outside of the /api/ routes.
+	Enabled bool   `yaml:"enabled" toml:"enabled"`
+	Port    string `yaml:"port" toml:"port"`
+}
+
+type HealthConfig struct {
+	// CacheTTL is how long a readiness result is reused.
+	CacheTTL time.Duration `yaml:"cache_ttl" toml:"cache_ttl"`
+	// CheckTimeout bounds all dependency checks of a readiness probe.
+	CheckTimeout time.Duration `yaml:"check_timeout" toml:"check_timeout"`
+}
+
+// envBinding maps an environment variable to the config field it overrides.
+// field must return a pointer to a string, bool, int, float64,
+// time.Duration or a comma separated []string.
+type envBinding struct {
+	env      string
+	key      string
+	required bool
+	field    func(*Config) any
+}
+
+var envBindings = []envBinding{
+	{"APP_PORT", "app.port", true, func(c *Config) any { return &c.App.Port }},
+	{"APP_SHUTDOWN_TIMEOUT", "app.shutdown_timeout", false, func(c *Config) any { return &c.App.ShutdownTimeout }},
+	{"APP_TRUST_REQUEST_ID", "app.trust_request_id", false, func(c *Config) any { return &c.App.TrustRequestID }},
+	{"APP_SHUTDOWN_DELAY", "app.shutdown_delay", false, func(c *Config) any { return &c.App.ShutdownDelay }},
+	{"APP_CURSOR_SECRET", "app.cursor_secret", false, func(c *Config) any { return &c.App.CursorSecret }},
+	{"APP_MAX_BODY_SIZE", "app.max_body_size", false, func(c *Config) any { return &c.App.MaxBodySize }},
+	{"MIST_BACKEND_APP_URL", "backend.url", true, func(c *Config) any { return &c.Backend.URL }},
+	{"MIST_BACKEND_TIMEOUT", "backend.timeout", false, func(c *Config) any { return &c.Backend.Timeout }},
+	{"MIST_BACKEND_MAX_TIMEOUT", "backend.max_timeout", false, func(c *Config) any { return &c.Backend.MaxTimeout }},
+	{"MIST_BACKEND_INSECURE", "backend.tls.insecure", false, func(c *Config) any { return &c.Backend.TLS.Insecure }},
+	{"MIST_BACKEND_TLS_CA_FILE", "backend.tls.ca_file", false, func(c *Config) any { return &c.Backend.TLS.CAFile }},
+	{"MIST_BACKEND_TLS_CERT_FILE", "backend.tls.cert_file", false, func(c *Config) any { return &c.Backend.TLS.CertFile }},
+	{"MIST_BACKEND_TLS_KEY_FILE", "backend.tls.key_file", false, func(c *Config) any { return &c.Backend.TLS.KeyFile }},
+	{"MIST_BACKEND_TLS_SERVER_NAME", "backend.tls.server_name", false, func(c *Config) any { return &c.Backend.TLS.ServerName }},
+	{"MIST_BACKEND_TLS_RELOAD_INTERVAL", "backend.tls.reload_interval", false, func(c *Config) any { return &c.Backend.TLS.ReloadInterval }},
+	{"MIST_BACKEND_RETRY_MAX_ATTEMPTS", "backend.retry.max_attempts", false, func(c *Config) any { return &c.Backend.Retry.MaxAttempts }},
+	{"MIST_BACKEND_RETRY_INITIAL_BACKOFF", "backend.retry.initial_backoff", false, func(c *Config) any { return &c.Backend.Retry.InitialBackoff }},
+	{"MIST_BACKEND_RETRY_MAX_BACKOFF", "backend.retry.max_backoff", false, func(c *Config) any { return &c.Backend.Retry.MaxBackoff }},
+	{"MIST_BACKEND_RETRY_BACKOFF_MULTIPLIER", "backend.retry.backoff_multiplier", false, func(c *Config) any { return &c.Backend.Retry.BackoffMultiplier }},
+	{"MIST_BACKEND_BREAKER_FAILURE_THRESHOLD", "backend.breaker.failure_threshold", false, func(c *Config) any { return &c.Backend.Breaker.FailureThreshold }},
+	{"MIST_BACKEND_BREAKER_OPEN_TIMEOUT", "backend.breaker.open_timeout", false, func(c *Config) any { return &c.Backend.Breaker.OpenTimeout }},
+	{"MIST_PY_API_JWT_SECRET_KEY", "auth.jwt_secret_key", false, func(c *Config) any { return &c.Auth.JWTSecretKey }},
+	{"MIST_PY_API_JWT_AUDIENCE", "auth.jwt_audience", true, func(c *Config) any { return &c.Auth.JWTAudience }},
+	{"MIST_PY_API_JWT_ISSUER", "auth.jwt_issuer", true, func(c *Config) any { return &c.Auth.JWTIssuer }},
+	{"MIST_PY_API_JWT_ALGORITHMS", "auth.jwt_algorithms", false, func(c *Config) any { return &c.Auth.JWTAlgorithms }},
+	{"MIST_PY_API_JWKS_URL", "auth.jwks.url", false, func(c *Config) any { return &c.Auth.JWKS.URL }},
+	{"MIST_PY_API_JWKS_FILE", "auth.jwks.file", false, func(c *Config) any { return &c.Auth.JWKS.File }},
+	{"MIST_PY_API_JWKS_REFRESH_INTERVAL", "auth.jwks.refresh_interval", false, func(c *Config) any { return &c.Auth.JWKS.RefreshInterval }},
+	{"MIST_PY_API_JWKS_MIN_REFRESH_INTERVAL", "auth.jwks.min_refresh_interval", false, func(c *Config) any { return &c.Auth.JWKS.MinRefreshInterval }},
+	{"MIST_PY_API_JWKS_GRACE_PERIOD", "auth.jwks.grace_period", false, func(c *Config) any { return &c.Auth.JWKS.GracePeriod }},
+	{"MIST_PY_API_JWT_USERNAME_CLAIM", "auth.username_claim", false, func(c *Config) any { return &c.Auth.UsernameClaim }},
+	{"AUTH_REVOCATION_STORE", "auth.revocation.store", false, func(c *Config) any { return &c.Auth.Revocation.Store }},
+	{"AUTH_REVOCATION_FILE", "auth.revocation.file", false, func(c *Config) any { return &c.Auth.Revocation.File }},
+	{"AUTH_MAX_TOKEN_LIFETIME", "auth.revocation.max_token_lifetime", false, func(c *Config) any { return &c.Auth.Revocation.MaxTokenLifetime }},
+	{"AUTH_ADMIN_USER_IDS", "auth.revocation.admin_user_ids", false, func(c *Config) any { return &c.Auth.Revocation.AdminUserIDs }},
+	{"AUTH_PROVISIONING_ENABLED", "auth.provisioning.enabled", false, func(c *Config) any { return &c.Auth.Provisioning.Enabled }},
+	{"AUTH_PROVISIONING_CACHE_TTL", "auth.provisioning.cache_ttl", false, func(c *Config) any { return &c.Auth.Provisioning.CacheTTL }},
+	{"LOG_FORMAT", "log.format", false, func(c *Config) any { return &c.Log.Format }},
+	{"LOG_LEVEL", "log.level", false, func(c *Config) any { return &c.Log.Level }},
+	{"TRACING_EXPORTER", "tracing.exporter", false, func(c *Config) any { return &c.Tracing.Exporter }},
+	{"TRACING_SERVICE_NAME", "tracing.service_name", false, func(c *Config) any { return &c.Tracing.ServiceName }},
+	{"TRACING_OTLP_ENDPOINT", "tracing.otlp_endpoint", false, func(c *Config) any { return &c.Tracing.OTLPEndpoint }},
+	{"TRACING_OTLP_INSECURE", "tracing.otlp_insecure", false, func(c *Config) any { return &c.Tracing.OTLPInsecure }},
+	{"TRACING_SAMPLE_RATIO", "tracing.sample_ratio", false, func(c *Config) any { return &c.Tracing.SampleRatio }},
+	{"METRICS_ENABLED", "metrics.enabled", false, func(c *Config) any { return &c.Metrics.Enabled }},
+	{"METRICS_PORT", "metrics.port", false, func(c *Config) any { return &c.Metrics.Port }},
+	{"HEALTH_CACHE_TTL", "health.cache_ttl", false, func(c *Config) any { return &c.Health.CacheTTL }},
+	{"HEALTH_CHECK_TIMEOUT", "health.check_timeout", false, func(c *Config) any { return &c.Health.CheckTimeout }},
+}
+
+// Default returns the configuration values used when neither the file nor
+// the environment set them.
+func Default() *Config {
+	return &Config{
+		App: AppConfig{
+			ShutdownTimeout: 15 * time.Second,
+			ShutdownDelay:   5 * time.Second,
+			MaxBodySize:     1 << 20,
+		},
+		Backend: BackendConfig{
+			Timeout:    DefaultBackendTimeout,
+			MaxTimeout: 30 * time.Second,
+			RouteTimeouts: map[string]time.Duration{
+				// the detail view fans out to several backend calls
+				"GET /api/v1/appservers/{id}": 10 * time.Second,
+			},
+			TLS: BackendTLSConfig{
+				ReloadInterval: time.Minute,
+			},
+			Retry: RetryConfig{
+				MaxAttempts:       3,
+				InitialBackoff:    100 * time.Millisecond,
+				MaxBackoff:        time.Second,
+				BackoffMultiplier: 2,
+			},
+			Breaker: BreakerConfig{
+				FailureThreshold: 5,
+				OpenTimeout:      10 * time.Second,
+			},
+		},
+		Auth: AuthConfig{
+			JWTAlgorithms: []string{"HS256"},
+			JWKS: JWKSConfig{
+				RefreshInterval:    5 * time.Minute,
+				MinRefreshInterval: 30 * time.Second,
+				GracePeriod:        time.Hour,
+			},
+			UsernameClaim: "username",
+			Revocation: RevocationConfig{
+				Store:            "memory",
+				MaxTokenLifetime: 24 * time.Hour,
+			},
+			Provisioning: ProvisioningConfig{
+				CacheTTL: time.Hour,
+			},
+		},
+		Log: LogConfig{
+			Format: "json",
+			Level:  "info",
+		},
+		Tracing: TracingConfig{
+			Exporter:    "none",
+			ServiceName: "mist-api",
+			SampleRatio: 1,
+		},
+		Health: HealthConfig{
+			CacheTTL:     2 * time.Second,
+			CheckTimeout: 2 * time.Second,
+		},
+	}
+}
+
+// Load builds the configuration from the file at path (if any) and the
+// process environment, and validates the result.
+func Load(path string) (*Config, error) {
+	cfg := Default()
+
+	if path != "" {
+		if err := loadFile(path, cfg); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := applyEnv(cfg); err != nil {
+		return nil, err
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+func loadFile(path string, cfg *Config) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("reading config file: %w", err)
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(content, cfg)
+	case ".toml":
+		err = toml.Unmarshal(content, cfg)
+	default:
+		return fmt.Errorf("unsupported config file format: %s", path)
+	}
+
+	if err != nil {
+		return fmt.Errorf("parsing config file %s: %w", path, err)
+	}
+	return nil
+}
+
+func applyEnv(cfg *Config) error {
+	for _, b := range envBindings {
+		v, ok := os.LookupEnv(b.env)
+		if !ok || v == "" {
+			continue
+		}
+
+		if err := setValue(b.field(cfg), v); err != nil {
+			return fmt.Errorf("invalid value for %s: %w", b.env, err)
+		}
+	}
+	return nil
+}
+
+func setValue(field any, v string) error {
+	var err error
+
+	switch f := field.(type) {
+	case *string:
+		*f = v
+	case *bool:
+		*f, err = strconv.ParseBool(v)
+	case *int:
+		*f, err = strconv.Atoi(v)
+	case *float64:
+		*f, err = strconv.ParseFloat(v, 64)
+	case *time.Duration:
+		*f, err = time.ParseDuration(v)
+	case *[]string:
+		*f = nil
+		for _, item := range strings.Split(v, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				*f = append(*f, item)
+			}
+		}
+	default:
+		err = fmt.Errorf("unsupported field type %T", field)
+	}
+
+	return err
+}
+
+func isZero(field any) bool {
+	switch f := field.(type) {
+	case *string:
+		return *f == ""
+	case *bool:
+		return !*f
+	case *int:
+		return *f == 0
+	case *float64:
+		return *f == 0
+	case *time.Duration:
+		return *f == 0
+	case *[]string:
+		return len(*f) == 0
+	}
+	return false
+}
+
+// Validate reports every missing required value at once so a misconfigured
+// deployment fails on startup instead of on the first request.
+func (c *Config) Validate() error {
+	var errs []error
+
+	for _, b := range envBindings {
+		if b.required && isZero(b.field(c)) {
+			errs = append(errs, fmt.Errorf("missing %s (env %s)", b.key, b.env))
+		}
+	}
+
+	if c.App.ShutdownTimeout < 0 {
+		errs = append(errs, fmt.Errorf("app.shutdown_timeout must not be negative"))
+	}
+
+	if c.App.ShutdownDelay < 0 {
+		errs = append(errs, fmt.Errorf("app.shutdown_delay must not be negative"))
+	}
+
+	if c.App.MaxBodySize <= 0 {
+		errs = append(errs, fmt.Errorf("app.max_body_size must be positive"))
+	}
+
+	if c.Health.CheckTimeout <= 0 {
+		errs = append(errs, fmt.Errorf("health.check_timeout must be positive"))
+	}
+
+	if c.Backend.Timeout <= 0 {
+		errs = append(errs, fmt.Errorf("backend.timeout must be positive"))
+	}
+
+	if c.Backend.MaxTimeout < c.Backend.Timeout {
+		errs = append(errs, fmt.Errorf("backend.max_timeout must not be lower than backend.timeout"))
+	}
+
+	for route, timeout := range c.Backend.RouteTimeouts {
+		if timeout <= 0 {
+			errs = append(errs, fmt.Errorf("backend.route_timeouts[%q] must be positive", route))
+		}
+	}
+
+	if (c.Backend.TLS.CertFile == "") != (c.Backend.TLS.KeyFile == "") {
+		errs = append(errs, fmt.Errorf("backend.tls.cert_file and backend.tls.key_file must be set together"))
+	}
+
+	if c.Backend.TLS.Insecure && (c.Backend.TLS.CAFile != "" || c.Backend.TLS.CertFile != "") {
+		errs = append(errs, fmt.Errorf("backend.tls.insecure cannot be combined with TLS files"))
+	}
+
+	if r := c.Backend.Retry; r.MaxAttempts < 1 || r.MaxAttempts > 5 {
+		errs = append(errs, fmt.Errorf("backend.retry.max_attempts must be between 1 and 5"))
+	} else if r.MaxAttempts > 1 {
+		if r.InitialBackoff <= 0 || r.MaxBackoff < r.InitialBackoff {
+			errs = append(errs, fmt.Errorf("backend.retry backoffs must be positive with max_backoff not lower than initial_backoff"))
+		}
+		if r.BackoffMultiplier <= 0 {
+			errs = append(errs, fmt.Errorf("backend.retry.backoff_multiplier must be positive"))
+		}
+	}
+
+	if c.Backend.Breaker.FailureThreshold < 0 {
+		errs = append(errs, fmt.Errorf("backend.breaker.failure_threshold must not be negative"))
+	} else if c.Backend.Breaker.FailureThreshold > 0 && c.Backend.Breaker.OpenTimeout <= 0 {
+		errs = append(errs, fmt.Errorf("backend.breaker.open_timeout must be positive"))
+	}
+
+	errs = append(errs, c.Auth.validate()...)
+
+	switch strings.ToLower(c.Log.Format) {
+	case "json", "text":
+	default:
+		errs = append(errs, fmt.Errorf("log.format must be json or text, got %q", c.Log.Format))
+	}
+
+	switch strings.ToLower(c.Log.Level) {
+	case "debug", "info", "warn", "error":
+	default:
+		errs = append(errs, fmt.Errorf("log.level must be debug, info, warn or error, got %q", c.Log.Level))
+	}
+
+	switch strings.ToLower(c.Tracing.Exporter) {
+	case "none", "stdout":
+	case "otlp":
+		if c.Tracing.OTLPEndpoint == "" {
+			errs = append(errs, fmt.Errorf("missing tracing.otlp_endpoint (env TRACING_OTLP_ENDPOINT)"))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("tracing.exporter must be none, stdout or otlp, got %q", c.Tracing.Exporter))
+	}
+
+	if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+		errs = append(errs, fmt.Errorf("tracing.sample_ratio must be between 0 and 1"))
+	}
+
+	if c.Metrics.Enabled && c.Metrics.Port != "" && c.Metrics.Port == c.App.Port {
+		errs = append(errs, fmt.Errorf("metrics.port must differ from app.port"))
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid configuration: %w", errors.Join(errs...))
+	}
+	return nil
+}
+
+// jwtAlgorithms are the signing algorithms that can be allowed, by whether
+// they are verified with the shared secret.
+var jwtAlgorithms = map[string]bool{
+	"HS256": true, "HS384": true, "HS512": true,
+	"RS256": false, "RS384": false, "RS512": false,
+	"PS256": false, "PS384": false, "PS512": false,
+	"ES256": false, "ES384": false, "ES512": false,
+	"EdDSA": false,
+}
+
+func (a AuthConfig) validate() []error {
+	var errs []error
+
+	if len(a.JWTAlgorithms) == 0 {
+		errs = append(errs, fmt.Errorf("auth.jwt_algorithms must not be empty"))
+	}
+
+	var symmetric, asymmetric bool
+	for _, alg := range a.JWTAlgorithms {
+		hmac, ok := jwtAlgorithms[alg]
+		switch {
+		case !ok:
+			errs = append(errs, fmt.Errorf("auth.jwt_algorithms: unsupported algorithm %q", alg))
+		case hmac:
+			symmetric = true
+		default:
+			asymmetric = true
+		}
+	}
+
+	if symmetric && a.JWTSecretKey == "" {
+		errs = append(errs, fmt.Errorf("missing auth.jwt_secret_key (env MIST_PY_API_JWT_SECRET_KEY)"))
+	}
+
+	if a.JWKS.URL != "" && a.JWKS.File != "" {
+		errs = append(errs, fmt.Errorf("auth.jwks.url and auth.jwks.file are mutually exclusive"))
+	}
+	if asymmetric && a.JWKS.URL == "" && a.JWKS.File == "" {
+		errs = append(errs, fmt.Errorf("auth.jwks.url or auth.jwks.file is required by asymmetric algorithms"))
+	}
+	if a.JWKS.RefreshInterval <= 0 || a.JWKS.MinRefreshInterval < 0 || a.JWKS.GracePeriod < 0 {
+		errs = append(errs, fmt.Errorf("auth.jwks intervals must not be negative and refresh_interval must be positive"))
+	}
+
+	switch a.Revocation.Store {
+	case "memory":
+	case "file":
+		if a.Revocation.File == "" {
+			errs = append(errs, fmt.Errorf("missing auth.revocation.file (env AUTH_REVOCATION_FILE)"))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("auth.revocation.store must be memory or file, got %q", a.Revocation.Store))
+	}
+	if a.Revocation.MaxTokenLifetime <= 0 {
+		errs = append(errs, fmt.Errorf("auth.revocation.max_token_lifetime must be positive"))
+	}
+
+	if a.UsernameClaim == "" {
+		errs = append(errs, fmt.Errorf("missing auth.username_claim (env MIST_PY_API_JWT_USERNAME_CLAIM)"))
+	}
+	if a.Provisioning.Enabled && a.Provisioning.CacheTTL <= 0 {
+		errs = append(errs, fmt.Errorf("auth.provisioning.cache_ttl must be positive"))
+	}
+
+	return errs
+}
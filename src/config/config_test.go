@@ -0,0 +1,270 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"mistapi/src/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeConfigFile(t *testing.T, name string, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func setRequiredEnv(t *testing.T) {
+	t.Setenv("APP_PORT", "8080")
+	t.Setenv("MIST_BACKEND_APP_URL", "localhost:50051")
+	t.Setenv("MIST_PY_API_JWT_SECRET_KEY", "secret")
+	t.Setenv("MIST_PY_API_JWT_AUDIENCE", "audience")
+	t.Setenv("MIST_PY_API_JWT_ISSUER", "issuer")
+}
+
+func TestLoad(t *testing.T) {
+	t.Run("Success:loads_values_from_env", func(t *testing.T) {
+		// ARRANGE
+		setRequiredEnv(t)
+
+		// ACT
+		cfg, err := config.Load("")
+
+		// ASSERT
+		require.NoError(t, err)
+		assert.Equal(t, "8080", cfg.App.Port)
+		assert.Equal(t, "localhost:50051", cfg.Backend.URL)
+		assert.Equal(t, "secret", cfg.Auth.JWTSecretKey)
+		assert.Equal(t, "audience", cfg.Auth.JWTAudience)
+		assert.Equal(t, "issuer", cfg.Auth.JWTIssuer)
+	})
+
+	t.Run("Success:loads_values_from_yaml_file", func(t *testing.T) {
+		// ARRANGE
+		path := writeConfigFile(t, "config.yaml", `
+app:
+  port: "9000"
+backend:
+  url: backend:50051
+auth:
+  jwt_secret_key: file-secret
+  jwt_audience: file-audience
+  jwt_issuer: file-issuer
+`)
+
+		// ACT
+		cfg, err := config.Load(path)
+
+		// ASSERT
+		require.NoError(t, err)
+		assert.Equal(t, "9000", cfg.App.Port)
+		assert.Equal(t, "backend:50051", cfg.Backend.URL)
+		assert.Equal(t, "file-secret", cfg.Auth.JWTSecretKey)
+	})
+
+	t.Run("Success:env_overrides_toml_file", func(t *testing.T) {
+		// ARRANGE
+		path := writeConfigFile(t, "config.toml", `
+[app]
+port = "9000"
+
+[backend]
+url = "backend:50051"
+
+[auth]
+jwt_secret_key = "file-secret"
+jwt_audience = "file-audience"
+jwt_issuer = "file-issuer"
+`)
+		t.Setenv("APP_PORT", "7000")
+
+		// ACT
+		cfg, err := config.Load(path)
+
+		// ASSERT
+		require.NoError(t, err)
+		assert.Equal(t, "7000", cfg.App.Port)
+		assert.Equal(t, "file-issuer", cfg.Auth.JWTIssuer)
+	})
+
+	t.Run("Success:shutdown_timeout_defaults_and_overrides", func(t *testing.T) {
+		// ARRANGE
+		setRequiredEnv(t)
+
+		// ACT
+		defaults, err := config.Load("")
+		require.NoError(t, err)
+
+		t.Setenv("APP_SHUTDOWN_TIMEOUT", "45s")
+		overridden, err := config.Load("")
+		require.NoError(t, err)
+
+		// ASSERT
+		assert.Equal(t, 15*time.Second, defaults.App.ShutdownTimeout)
+		assert.Equal(t, 45*time.Second, overridden.App.ShutdownTimeout)
+	})
+
+	t.Run("Success:max_body_size_defaults_and_overrides", func(t *testing.T) {
+		// ARRANGE
+		setRequiredEnv(t)
+
+		// ACT
+		defaults, err := config.Load("")
+		require.NoError(t, err)
+
+		t.Setenv("APP_MAX_BODY_SIZE", "4096")
+		overridden, err := config.Load("")
+		require.NoError(t, err)
+
+		// ASSERT
+		assert.Equal(t, 1<<20, defaults.App.MaxBodySize)
+		assert.Equal(t, 4096, overridden.App.MaxBodySize)
+	})
+
+	t.Run("Error:invalid_duration_in_env", func(t *testing.T) {
+		// ARRANGE
+		setRequiredEnv(t)
+		t.Setenv("APP_SHUTDOWN_TIMEOUT", "soon")
+
+		// ACT
+		_, err := config.Load("")
+
+		// ASSERT
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid value for APP_SHUTDOWN_TIMEOUT")
+	})
+
+	t.Run("Error:missing_values_are_all_reported", func(t *testing.T) {
+		// ARRANGE
+		setRequiredEnv(t)
+		t.Setenv("MIST_BACKEND_APP_URL", "")
+		t.Setenv("MIST_PY_API_JWT_ISSUER", "")
+
+		// ACT
+		cfg, err := config.Load("")
+
+		// ASSERT
+		assert.Nil(t, cfg)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "missing backend.url (env MIST_BACKEND_APP_URL)")
+		assert.Contains(t, err.Error(), "missing auth.jwt_issuer (env MIST_PY_API_JWT_ISSUER)")
+	})
+
+	t.Run("Error:backend_tls_settings_are_validated", func(t *testing.T) {
+		// ARRANGE
+		setRequiredEnv(t)
+		t.Setenv("MIST_BACKEND_INSECURE", "true")
+		t.Setenv("MIST_BACKEND_TLS_CA_FILE", "/etc/mist/ca.pem")
+		t.Setenv("MIST_BACKEND_TLS_CERT_FILE", "/etc/mist/client.pem")
+
+		// ACT
+		_, err := config.Load("")
+
+		// ASSERT
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "backend.tls.cert_file and backend.tls.key_file must be set together")
+		assert.Contains(t, err.Error(), "backend.tls.insecure cannot be combined with TLS files")
+	})
+
+	t.Run("Error:backend_retry_and_breaker_settings_are_validated", func(t *testing.T) {
+		// ARRANGE
+		setRequiredEnv(t)
+		t.Setenv("MIST_BACKEND_RETRY_MAX_ATTEMPTS", "6")
+		t.Setenv("MIST_BACKEND_BREAKER_FAILURE_THRESHOLD", "3")
+		t.Setenv("MIST_BACKEND_BREAKER_OPEN_TIMEOUT", "0s")
+
+		// ACT
+		_, err := config.Load("")
+
+		// ASSERT
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "backend.retry.max_attempts must be between 1 and 5")
+		assert.Contains(t, err.Error(), "backend.breaker.open_timeout must be positive")
+	})
+
+	t.Run("Success:asymmetric_algorithms_do_not_need_the_secret", func(t *testing.T) {
+		// ARRANGE
+		setRequiredEnv(t)
+		t.Setenv("MIST_PY_API_JWT_SECRET_KEY", "")
+		t.Setenv("MIST_PY_API_JWT_ALGORITHMS", "RS256, EdDSA")
+		t.Setenv("MIST_PY_API_JWKS_URL", "https://auth.example.com/.well-known/jwks.json")
+
+		// ACT
+		cfg, err := config.Load("")
+
+		// ASSERT
+		require.NoError(t, err)
+		assert.Equal(t, []string{"RS256", "EdDSA"}, cfg.Auth.JWTAlgorithms)
+	})
+
+	t.Run("Error:auth_algorithms_are_validated", func(t *testing.T) {
+		// ARRANGE
+		setRequiredEnv(t)
+		t.Setenv("MIST_PY_API_JWT_SECRET_KEY", "")
+		t.Setenv("MIST_PY_API_JWT_ALGORITHMS", "HS256,ES256,none")
+
+		// ACT
+		_, err := config.Load("")
+
+		// ASSERT
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `unsupported algorithm "none"`)
+		assert.Contains(t, err.Error(), "missing auth.jwt_secret_key (env MIST_PY_API_JWT_SECRET_KEY)")
+		assert.Contains(t, err.Error(), "auth.jwks.url or auth.jwks.file is required by asymmetric algorithms")
+	})
+
+	t.Run("Success:provisioning_defaults_and_overrides", func(t *testing.T) {
+		// ARRANGE
+		setRequiredEnv(t)
+		t.Setenv("AUTH_PROVISIONING_ENABLED", "true")
+		t.Setenv("MIST_PY_API_JWT_USERNAME_CLAIM", "preferred_username")
+
+		// ACT
+		cfg, err := config.Load("")
+
+		// ASSERT
+		require.NoError(t, err)
+		assert.True(t, cfg.Auth.Provisioning.Enabled)
+		assert.Equal(t, time.Hour, cfg.Auth.Provisioning.CacheTTL)
+		assert.Equal(t, "preferred_username", cfg.Auth.UsernameClaim)
+	})
+
+	t.Run("Error:provisioning_cache_ttl_is_validated", func(t *testing.T) {
+		// ARRANGE
+		setRequiredEnv(t)
+		t.Setenv("AUTH_PROVISIONING_ENABLED", "true")
+		t.Setenv("AUTH_PROVISIONING_CACHE_TTL", "0s")
+
+		// ACT
+		_, err := config.Load("")
+
+		// ASSERT
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "auth.provisioning.cache_ttl must be positive")
+	})
+
+	t.Run("Error:unsupported_file_format", func(t *testing.T) {
+		// ARRANGE
+		path := writeConfigFile(t, "config.json", `{}`)
+
+		// ACT
+		_, err := config.Load(path)
+
+		// ASSERT
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unsupported config file format")
+	})
+
+	t.Run("Error:missing_file", func(t *testing.T) {
+		// ACT
+		_, err := config.Load(filepath.Join(t.TempDir(), "missing.yaml"))
+
+		// ASSERT
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "reading config file")
+	})
+}
@@ -0,0 +1,327 @@
+// Package events fans out the changes made through the gateway to the
+// clients subscribed to an appserver. Events only live in this process.
+package events
+
+import (
+	"context"
+	"maps"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+)
+
+// Type names an event, clients switch on it.
+type Type string
+
+const (
+	ChannelCreated Type = "channel.created"
+	ChannelUpdated Type = "channel.updated"
+	ChannelDeleted Type = "channel.deleted"
+	RoleAssigned   Type = "role.assigned"
+	RoleUnassigned Type = "role.unassigned"
+	MemberJoined   Type = "member.joined"
+	MemberLeft     Type = "member.left"
+)
+
+// replaySize is how many of the latest events, of every appserver, the
+// broker keeps for subscribers resuming after a disconnect.
+const replaySize = 1024
+
+// Event is a change in an appserver.
+type Event struct {
+	// ID increases with every event published on the broker.
+	ID          uint64    `json:"id"`
+	Type        Type      `json:"type"`
+	AppserverID string    `json:"appserver_id"`
+	ChannelID   string    `json:"channel_id,omitempty"`
+	Data        any       `json:"data,omitempty"`
+	Time        time.Time `json:"time"`
+	// Private tells whether the channel of the event is private, nil when
+	// the publisher does not know.
+	Private *bool `json:"-"`
+	// AppuserID is the member whose membership or roles the event changes.
+	// Their subscriptions to the appserver are updated when it is published,
+	// those of every subscriber when it is empty.
+	AppuserID string `json:"-"`
+}
+
+// View decides which events of an appserver a subscriber may see.
+type View struct {
+	// Owner sees every event.
+	Owner bool
+	// Private maps the private channels of the appserver to whether the
+	// subscriber may view them. Events of other channels are visible.
+	Private map[string]bool
+}
+
+// allows reports whether e is visible and keeps the view up to date with
+// channels changing visibility. It is called with the broker lock held.
+func (v *View) allows(e Event) bool {
+	if e.ChannelID == "" || v.Owner {
+		return true
+	}
+
+	switch {
+	case e.Private == nil:
+		visible, known := v.Private[e.ChannelID]
+		return !known || visible
+	case *e.Private:
+		// the roles of a channel that just became private are unknown,
+		// only channels known to be visible stay visible
+		visible, known := v.Private[e.ChannelID]
+		if !known {
+			v.Private[e.ChannelID] = false
+		}
+		return visible
+	default:
+		delete(v.Private, e.ChannelID)
+		return true
+	}
+}
+
+// Subscriber receives the events of the appservers it joined.
+type Subscriber struct {
+	userID  string
+	c       chan Event
+	dropped chan struct{}
+	once    sync.Once
+	views   map[string]*View
+	refresh chan struct{}
+	// stale holds the appservers whose view must be reloaded
+	stale map[string]bool
+}
+
+// Events returns the events delivered to the subscriber.
+func (s *Subscriber) Events() <-chan Event {
+	return s.c
+}
+
+// Dropped is closed once an event could not be delivered because the
+// subscriber fell behind. Later events are still delivered, the subscriber
+// is expected to resync.
+func (s *Subscriber) Dropped() <-chan struct{} {
+	return s.dropped
+}
+
+// Refresh receives a value when the membership or roles of the subscriber
+// changed in appservers it joined. Broker.Stale tells which.
+func (s *Subscriber) Refresh() <-chan struct{} {
+	return s.refresh
+}
+
+// Broker delivers published events to the subscribers of their appserver.
+type Broker struct {
+	mu          sync.Mutex
+	subscribers map[string]map[*Subscriber]struct{}
+	lastID      uint64
+	// replay holds the latest events in publication order
+	replay []Event
+}
+
+func NewBroker() *Broker {
+	return &Broker{subscribers: map[string]map[*Subscriber]struct{}{}}
+}
+
+// Subscribe returns a subscriber of userID buffering up to buffer events. It
+// receives nothing until it joins an appserver.
+func (b *Broker) Subscribe(userID string, buffer int) *Subscriber {
+	return &Subscriber{
+		userID:  userID,
+		c:       make(chan Event, buffer),
+		dropped: make(chan struct{}),
+		views:   map[string]*View{},
+		refresh: make(chan struct{}, 1),
+		stale:   map[string]bool{},
+	}
+}
+
+// Join delivers the events of appserverID allowed by view to s.
+func (b *Broker) Join(s *Subscriber, appserverID string, view *View) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.join(s, appserverID, view)
+}
+
+// JoinAfter joins like Join and returns the retained events of appserverID
+// published after the event with ID after and allowed by view. Events
+// published later are delivered to s. It returns false when some of the
+// events after it are no longer retained, or after is from an earlier run.
+func (b *Broker) JoinAfter(s *Subscriber, appserverID string, view *View, after uint64) ([]Event, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.join(s, appserverID, view)
+
+	complete := after <= b.lastID
+	if len(b.replay) > 0 && after+1 < b.replay[0].ID {
+		complete = false
+	}
+
+	// older visibility changes must not leak into the current view
+	past := &View{Owner: view.Owner, Private: maps.Clone(view.Private)}
+	var missed []Event
+	for _, e := range b.replay {
+		if e.ID > after && e.AppserverID == appserverID && past.allows(e) {
+			missed = append(missed, e)
+		}
+	}
+	return missed, complete
+}
+
+func (b *Broker) join(s *Subscriber, appserverID string, view *View) {
+	if view.Private == nil {
+		view.Private = map[string]bool{}
+	}
+	s.views[appserverID] = view
+
+	if b.subscribers[appserverID] == nil {
+		b.subscribers[appserverID] = map[*Subscriber]struct{}{}
+	}
+	b.subscribers[appserverID][s] = struct{}{}
+}
+
+// Update replaces the view of s in appserverID. It returns false, leaving
+// s as is, when s no longer joins appserverID.
+func (b *Broker) Update(s *Subscriber, appserverID string, view *View) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := s.views[appserverID]; !ok {
+		return false
+	}
+	b.join(s, appserverID, view)
+	return true
+}
+
+// Joined returns the appservers s joined.
+func (b *Broker) Joined(s *Subscriber) []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return slices.Collect(maps.Keys(s.views))
+}
+
+// Stale returns the appservers whose view of s must be reloaded since the
+// last call, see Subscriber.Refresh. Appservers s was made to leave are
+// included.
+func (b *Broker) Stale(s *Subscriber) []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	stale := slices.Collect(maps.Keys(s.stale))
+	clear(s.stale)
+	return stale
+}
+
+// Leave stops delivering the events of appserverID to s.
+func (b *Broker) Leave(s *Subscriber, appserverID string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.leave(s, appserverID)
+}
+
+// Unsubscribe stops delivering events to s.
+func (b *Broker) Unsubscribe(s *Subscriber) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for appserverID := range s.views {
+		b.leave(s, appserverID)
+	}
+}
+
+func (b *Broker) leave(s *Subscriber, appserverID string) {
+	delete(s.views, appserverID)
+	delete(b.subscribers[appserverID], s)
+	if len(b.subscribers[appserverID]) == 0 {
+		delete(b.subscribers, appserverID)
+	}
+}
+
+// Publish delivers e to the subscribers of its appserver allowed to see it.
+// It never blocks, subscribers that fell behind miss the event.
+func (b *Broker) Publish(e Event) {
+	if e.Time.IsZero() {
+		e.Time = time.Now().UTC()
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.lastID++
+	e.ID = b.lastID
+	if len(b.replay) == replaySize {
+		b.replay = b.replay[1:]
+	}
+	b.replay = append(b.replay, e)
+
+	for s := range b.subscribers[e.AppserverID] {
+		if !s.views[e.AppserverID].allows(e) {
+			continue
+		}
+
+		select {
+		case s.c <- e:
+		default:
+			s.once.Do(func() { close(s.dropped) })
+		}
+	}
+
+	b.memberChanged(e)
+}
+
+// memberChanged applies e to the subscriptions of the member it is about,
+// who already received it. A member leaving stops receiving the events of the
+// appserver, and a member losing a role sees no private channel until their
+// view is reloaded. When e has no AppuserID it may be about any subscriber,
+// so all of them are treated alike and a member who left is only dropped
+// once their view fails to reload.
+func (b *Broker) memberChanged(e Event) {
+	for s := range b.subscribers[e.AppserverID] {
+		if e.AppuserID != "" && s.userID != e.AppuserID {
+			continue
+		}
+
+		switch e.Type {
+		case MemberLeft:
+			if e.AppuserID != "" {
+				b.leave(s, e.AppserverID)
+			}
+		case RoleUnassigned:
+			for id := range s.views[e.AppserverID].Private {
+				s.views[e.AppserverID].Private[id] = false
+			}
+		case RoleAssigned:
+		default:
+			continue
+		}
+
+		s.stale[e.AppserverID] = true
+		select {
+		case s.refresh <- struct{}{}:
+		default:
+		}
+	}
+}
+
+type brokerContextKey struct{}
+
+// Middleware makes b available to Publish for the handlers behind it.
+func Middleware(b *Broker) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), brokerContextKey{}, b)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// Publish publishes e on the broker of ctx, if any.
+func Publish(ctx context.Context, e Event) {
+	if b, ok := ctx.Value(brokerContextKey{}).(*Broker); ok {
+		b.Publish(e)
+	}
+}
@@ -0,0 +1,306 @@
+package events_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"mistapi/src/events"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func private(b bool) *bool {
+	return &b
+}
+
+// drain returns the types of the events delivered to s so far.
+func drain(s *events.Subscriber) []events.Type {
+	types := []events.Type{}
+	for {
+		select {
+		case e := <-s.Events():
+			types = append(types, e.Type)
+		default:
+			return types
+		}
+	}
+}
+
+func TestBroker(t *testing.T) {
+	t.Run("Success:delivers_events_of_joined_appservers", func(t *testing.T) {
+		// ARRANGE
+		b := events.NewBroker()
+		s := b.Subscribe("user", 10)
+		b.Join(s, "server", &events.View{})
+
+		// ACT
+		b.Publish(events.Event{Type: events.MemberJoined, AppserverID: "server"})
+		b.Publish(events.Event{Type: events.MemberLeft, AppserverID: "other"})
+
+		// ASSERT
+		e := <-s.Events()
+		assert.Equal(t, events.MemberJoined, e.Type)
+		assert.False(t, e.Time.IsZero())
+		assert.Empty(t, drain(s))
+	})
+
+	t.Run("Success:private_channels_are_filtered", func(t *testing.T) {
+		// ARRANGE
+		b := events.NewBroker()
+		member := b.Subscribe("user", 10)
+		b.Join(member, "server", &events.View{Private: map[string]bool{"hidden": false, "allowed": true}})
+		owner := b.Subscribe("user", 10)
+		b.Join(owner, "server", &events.View{Owner: true})
+
+		// ACT
+		b.Publish(events.Event{Type: events.ChannelDeleted, AppserverID: "server", ChannelID: "hidden"})
+		b.Publish(events.Event{Type: events.ChannelUpdated, AppserverID: "server", ChannelID: "allowed"})
+		b.Publish(events.Event{Type: events.ChannelCreated, AppserverID: "server", ChannelID: "public"})
+
+		// ASSERT
+		assert.Equal(t, []events.Type{events.ChannelUpdated, events.ChannelCreated}, drain(member))
+		assert.Len(t, drain(owner), 3)
+	})
+
+	t.Run("Success:channels_changing_visibility_are_tracked", func(t *testing.T) {
+		// ARRANGE
+		b := events.NewBroker()
+		s := b.Subscribe("user", 10)
+		b.Join(s, "server", &events.View{})
+		e := events.Event{Type: events.ChannelUpdated, AppserverID: "server", ChannelID: "channel"}
+
+		// ACT
+		e.Private = private(true)
+		b.Publish(e)
+		e.Private = nil
+		b.Publish(e)
+		e.Private = private(false)
+		b.Publish(e)
+		e.Private = nil
+		b.Publish(e)
+
+		// ASSERT
+		assert.Equal(t, []events.Type{events.ChannelUpdated, events.ChannelUpdated}, drain(s))
+	})
+
+	t.Run("Success:left_appservers_are_not_delivered", func(t *testing.T) {
+		// ARRANGE
+		b := events.NewBroker()
+		s := b.Subscribe("user", 10)
+		b.Join(s, "server", &events.View{})
+		b.Join(s, "other", &events.View{})
+
+		// ACT
+		b.Leave(s, "server")
+		b.Publish(events.Event{Type: events.MemberJoined, AppserverID: "server"})
+		b.Publish(events.Event{Type: events.MemberJoined, AppserverID: "other"})
+		b.Unsubscribe(s)
+		b.Publish(events.Event{Type: events.MemberJoined, AppserverID: "other"})
+
+		// ASSERT
+		assert.Len(t, drain(s), 1)
+	})
+
+	t.Run("Success:member_leaving_stops_receiving_events", func(t *testing.T) {
+		// ARRANGE
+		b := events.NewBroker()
+		kicked := b.Subscribe("kicked", 10)
+		b.Join(kicked, "server", &events.View{})
+		other := b.Subscribe("other", 10)
+		b.Join(other, "server", &events.View{})
+
+		// ACT
+		b.Publish(events.Event{Type: events.MemberLeft, AppserverID: "server", AppuserID: "kicked"})
+		b.Publish(events.Event{Type: events.ChannelCreated, AppserverID: "server", ChannelID: "public"})
+
+		// ASSERT
+		assert.Equal(t, []events.Type{events.MemberLeft}, drain(kicked))
+		assert.Len(t, drain(other), 2)
+		assert.Empty(t, b.Joined(kicked))
+		<-kicked.Refresh()
+		assert.Equal(t, []string{"server"}, b.Stale(kicked))
+		assert.Empty(t, b.Stale(other))
+	})
+
+	t.Run("Success:member_losing_a_role_sees_no_private_channel_until_updated", func(t *testing.T) {
+		// ARRANGE
+		b := events.NewBroker()
+		s := b.Subscribe("user", 10)
+		b.Join(s, "server", &events.View{Private: map[string]bool{"private": true}})
+		e := events.Event{Type: events.ChannelUpdated, AppserverID: "server", ChannelID: "private"}
+
+		// ACT
+		b.Publish(events.Event{Type: events.RoleUnassigned, AppserverID: "server", AppuserID: "user"})
+		b.Publish(e)
+		<-s.Refresh()
+		stale := b.Stale(s)
+		updated := b.Update(s, "server", &events.View{Private: map[string]bool{"private": true}})
+		b.Publish(e)
+
+		// ASSERT
+		assert.Equal(t, []string{"server"}, stale)
+		assert.True(t, updated)
+		assert.Equal(t, []events.Type{events.RoleUnassigned, events.ChannelUpdated}, drain(s))
+	})
+
+	t.Run("Success:change_of_an_unknown_member_reloads_every_view", func(t *testing.T) {
+		// ARRANGE
+		b := events.NewBroker()
+		first := b.Subscribe("first", 10)
+		b.Join(first, "server", &events.View{Private: map[string]bool{"private": true}})
+		second := b.Subscribe("second", 10)
+		b.Join(second, "server", &events.View{})
+
+		// ACT
+		b.Publish(events.Event{Type: events.RoleUnassigned, AppserverID: "server"})
+		b.Publish(events.Event{Type: events.MemberLeft, AppserverID: "server"})
+		b.Publish(events.Event{Type: events.ChannelUpdated, AppserverID: "server", ChannelID: "private"})
+
+		// ASSERT
+		<-first.Refresh()
+		<-second.Refresh()
+		assert.Equal(t, []events.Type{events.RoleUnassigned, events.MemberLeft}, drain(first))
+		assert.Equal(t, []string{"server"}, b.Stale(first))
+		assert.Equal(t, []string{"server"}, b.Joined(second))
+		assert.Equal(t, []string{"server"}, b.Stale(second))
+	})
+
+	t.Run("Error:update_of_a_left_appserver_is_refused", func(t *testing.T) {
+		// ARRANGE
+		b := events.NewBroker()
+		s := b.Subscribe("user", 10)
+		b.Join(s, "server", &events.View{})
+		b.Leave(s, "server")
+
+		// ACT
+		updated := b.Update(s, "server", &events.View{})
+
+		// ASSERT
+		assert.False(t, updated)
+		assert.Empty(t, b.Joined(s))
+	})
+
+	t.Run("Error:full_subscriber_is_dropped", func(t *testing.T) {
+		// ARRANGE
+		b := events.NewBroker()
+		s := b.Subscribe("user", 1)
+		b.Join(s, "server", &events.View{})
+
+		// ACT
+		b.Publish(events.Event{Type: events.MemberJoined, AppserverID: "server"})
+		b.Publish(events.Event{Type: events.MemberLeft, AppserverID: "server"})
+		b.Publish(events.Event{Type: events.MemberLeft, AppserverID: "server"})
+
+		// ASSERT
+		assert.Equal(t, []events.Type{events.MemberJoined}, drain(s))
+		select {
+		case <-s.Dropped():
+		default:
+			t.Fatal("subscriber was not dropped")
+		}
+	})
+}
+
+func TestBrokerJoinAfter(t *testing.T) {
+	publish := func(b *events.Broker, appserverID, channelID string, isPrivate *bool) {
+		b.Publish(events.Event{
+			Type: events.ChannelUpdated, AppserverID: appserverID, ChannelID: channelID, Private: isPrivate,
+		})
+	}
+
+	t.Run("Success:replays_events_after_the_given_id", func(t *testing.T) {
+		// ARRANGE
+		b := events.NewBroker()
+		publish(b, "server", "a", nil)
+		publish(b, "other", "b", nil)
+		publish(b, "server", "c", nil)
+		s := b.Subscribe("user", 10)
+
+		// ACT
+		missed, complete := b.JoinAfter(s, "server", &events.View{}, 1)
+		publish(b, "server", "d", nil)
+
+		// ASSERT
+		assert.True(t, complete)
+		assert.Len(t, missed, 1)
+		assert.Equal(t, uint64(3), missed[0].ID)
+		e := <-s.Events()
+		assert.Equal(t, uint64(4), e.ID)
+	})
+
+	t.Run("Success:replay_respects_the_current_visibility", func(t *testing.T) {
+		// ARRANGE
+		b := events.NewBroker()
+		publish(b, "server", "hidden", private(false))
+		publish(b, "server", "hidden", private(true))
+		publish(b, "server", "hidden", nil)
+		s := b.Subscribe("user", 10)
+		view := &events.View{Private: map[string]bool{"hidden": false}}
+
+		// ACT
+		missed, _ := b.JoinAfter(s, "server", view, 0)
+		publish(b, "server", "hidden", nil)
+
+		// ASSERT
+		assert.Len(t, missed, 1)
+		assert.Equal(t, map[string]bool{"hidden": false}, view.Private)
+		assert.Empty(t, drain(s))
+	})
+
+	t.Run("Error:evicted_events_are_reported", func(t *testing.T) {
+		// ARRANGE
+		b := events.NewBroker()
+		for range 2000 {
+			publish(b, "server", "", nil)
+		}
+		s := b.Subscribe("user", 10)
+
+		// ACT
+		missed, complete := b.JoinAfter(s, "server", &events.View{}, 10)
+
+		// ASSERT
+		assert.False(t, complete)
+		assert.NotEmpty(t, missed)
+	})
+
+	t.Run("Error:ids_from_an_earlier_run_are_reported", func(t *testing.T) {
+		// ARRANGE
+		b := events.NewBroker()
+		publish(b, "server", "", nil)
+		s := b.Subscribe("user", 10)
+
+		// ACT
+		missed, complete := b.JoinAfter(s, "server", &events.View{}, 50)
+
+		// ASSERT
+		assert.False(t, complete)
+		assert.Empty(t, missed)
+	})
+}
+
+func TestPublish(t *testing.T) {
+	t.Run("Success:publishes_on_the_request_broker", func(t *testing.T) {
+		// ARRANGE
+		b := events.NewBroker()
+		s := b.Subscribe("user", 1)
+		b.Join(s, "server", &events.View{})
+		handler := events.Middleware(b)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			events.Publish(r.Context(), events.Event{Type: events.MemberJoined, AppserverID: "server"})
+		}))
+
+		// ACT
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/", nil))
+
+		// ASSERT
+		assert.Equal(t, []events.Type{events.MemberJoined}, drain(s))
+	})
+
+	t.Run("Success:without_broker_is_a_no_op", func(t *testing.T) {
+		// ACT & ASSERT
+		assert.NotPanics(t, func() {
+			events.Publish(context.Background(), events.Event{Type: events.MemberJoined})
+		})
+	})
+}
@@ -0,0 +1,102 @@
+package logging
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"mistapi/src/config"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+type contextKey struct{}
+
+// requestLogger is shared by everything serving a request, so attributes added
+// deep in the middleware chain (e.g. user_id) end up in the access log too.
+type requestLogger struct {
+	logger *slog.Logger
+}
+
+// New builds a logger writing to w in the format and level set in cfg.
+func New(w io.Writer, cfg config.LogConfig) *slog.Logger {
+	opts := &slog.HandlerOptions{Level: ParseLevel(cfg.Level)}
+
+	if strings.EqualFold(cfg.Format, "text") {
+		return slog.New(slog.NewTextHandler(w, opts))
+	}
+	return slog.New(slog.NewJSONHandler(w, opts))
+}
+
+// ParseLevel maps debug, info, warn and error to their slog level. Anything
+// else is treated as info.
+func ParseLevel(level string) slog.Level {
+	var l slog.Level
+	if err := l.UnmarshalText([]byte(level)); err != nil {
+		return slog.LevelInfo
+	}
+	return l
+}
+
+// WithLogger returns a copy of ctx carrying logger.
+func WithLogger(ctx context.Context, logger *slog.Logger) context.Context {
+	return context.WithValue(ctx, contextKey{}, &requestLogger{logger: logger})
+}
+
+// FromContext returns the request scoped logger, including the matched route
+// pattern when known. It falls back to slog.Default().
+func FromContext(ctx context.Context) *slog.Logger {
+	rl, ok := ctx.Value(contextKey{}).(*requestLogger)
+	if !ok {
+		return slog.Default()
+	}
+
+	if route := chi.RouteContext(ctx).RoutePattern(); route != "" {
+		return rl.logger.With("route", route)
+	}
+	return rl.logger
+}
+
+// AddAttrs adds attributes to the request scoped logger stored in ctx.
+func AddAttrs(ctx context.Context, args ...any) {
+	if rl, ok := ctx.Value(contextKey{}).(*requestLogger); ok {
+		rl.logger = rl.logger.With(args...)
+	}
+}
+
+// Middleware stores a logger carrying the request ID in the request context
+// and writes an access log line once the request is served. It must run after
+// middleware.RequestID.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+
+		logger := slog.Default().With("request_id", middleware.GetReqID(r.Context()))
+		ctx := WithLogger(r.Context(), logger)
+
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+		next.ServeHTTP(ww, r.WithContext(ctx))
+
+		status := ww.Status()
+		if status == 0 {
+			status = http.StatusOK
+		}
+
+		level := slog.LevelInfo
+		if status >= http.StatusInternalServerError {
+			level = slog.LevelError
+		}
+
+		FromContext(ctx).Log(ctx, level, "Request served",
+			"method", r.Method,
+			"path", r.URL.Path,
+			"status", status,
+			"bytes", ww.BytesWritten(),
+			"duration", time.Since(start),
+		)
+	})
+}
@@ -0,0 +1,104 @@
+package logging_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"mistapi/src/config"
+	"mistapi/src/logging"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("Success:json_format", func(t *testing.T) {
+		// ARRANGE
+		buf := new(bytes.Buffer)
+		logger := logging.New(buf, config.LogConfig{Format: "json", Level: "info"})
+
+		// ACT
+		logger.Info("hello", "foo", "bar")
+
+		// ASSERT
+		var line map[string]any
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &line))
+		assert.Equal(t, "hello", line["msg"])
+		assert.Equal(t, "bar", line["foo"])
+	})
+
+	t.Run("Success:text_format_and_level", func(t *testing.T) {
+		// ARRANGE
+		buf := new(bytes.Buffer)
+		logger := logging.New(buf, config.LogConfig{Format: "text", Level: "warn"})
+
+		// ACT
+		logger.Info("ignored")
+		logger.Warn("kept")
+
+		// ASSERT
+		assert.NotContains(t, buf.String(), "ignored")
+		assert.Contains(t, buf.String(), "msg=kept")
+	})
+}
+
+func TestParseLevel(t *testing.T) {
+	assert.Equal(t, slog.LevelDebug, logging.ParseLevel("debug"))
+	assert.Equal(t, slog.LevelError, logging.ParseLevel("ERROR"))
+	assert.Equal(t, slog.LevelInfo, logging.ParseLevel("unknown"))
+}
+
+func TestFromContext(t *testing.T) {
+	t.Run("Success:falls_back_to_default_logger", func(t *testing.T) {
+		assert.Equal(t, slog.Default(), logging.FromContext(context.Background()))
+	})
+}
+
+func TestMiddleware(t *testing.T) {
+	// slog.SetDefault is process wide, so these tests do not run in parallel.
+	original := slog.Default()
+	t.Cleanup(func() { slog.SetDefault(original) })
+
+	buf := new(bytes.Buffer)
+	slog.SetDefault(logging.New(buf, config.LogConfig{Format: "json", Level: "info"}))
+
+	r := chi.NewRouter()
+	r.Use(middleware.RequestID)
+	r.Use(logging.Middleware)
+	r.Get("/servers/{id}", func(w http.ResponseWriter, r *http.Request) {
+		logging.AddAttrs(r.Context(), "user_id", "user-1")
+		logging.FromContext(r.Context()).Info("inside handler")
+		w.WriteHeader(http.StatusTeapot)
+	})
+
+	// ACT
+	req := httptest.NewRequest(http.MethodGet, "/servers/123", nil)
+	r.ServeHTTP(httptest.NewRecorder(), req)
+
+	// ASSERT
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+
+	var handlerLine, accessLine map[string]any
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &handlerLine))
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &accessLine))
+
+	assert.Equal(t, "inside handler", handlerLine["msg"])
+	assert.Equal(t, "/servers/{id}", handlerLine["route"])
+	assert.Equal(t, "user-1", handlerLine["user_id"])
+	assert.NotEmpty(t, handlerLine["request_id"])
+
+	assert.Equal(t, "Request served", accessLine["msg"])
+	assert.Equal(t, handlerLine["request_id"], accessLine["request_id"])
+	assert.Equal(t, "user-1", accessLine["user_id"])
+	assert.Equal(t, "/servers/{id}", accessLine["route"])
+	assert.EqualValues(t, http.StatusTeapot, accessLine["status"])
+}
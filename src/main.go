@@ -1,7 +1,16 @@
 package main
 
 import (
+	"context"
+	"log"
+	"log/slog"
+	"os"
+	"time"
+
 	"mistapi/src/api"
+	"mistapi/src/config"
+	"mistapi/src/logging"
+	"mistapi/src/tracing"
 )
 
 // @title Mist API Docs
@@ -21,5 +30,30 @@ import (
 // @name Authorization
 // @description Type "Bearer" followed by a space and JWT token.
 func main() {
-	api.StartService()
+	cfg, err := config.Load(os.Getenv(config.ConfigFileEnv))
+	if err != nil {
+		log.Fatalf("Error loading configuration: %v", err)
+	}
+
+	slog.SetDefault(logging.New(os.Stdout, cfg.Log))
+
+	shutdownTracing, err := tracing.Setup(context.Background(), cfg.Tracing)
+	if err != nil {
+		slog.Error("Error setting up tracing", "error", err)
+		os.Exit(1)
+	}
+
+	err = api.StartService(cfg)
+
+	// flush the spans of the drained requests
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	if tErr := shutdownTracing(ctx); tErr != nil {
+		slog.Error("Error flushing traces", "error", tErr)
+	}
+	cancel()
+
+	if err != nil {
+		slog.Error("Error running service", "error", err)
+		os.Exit(1)
+	}
 }
@@ -0,0 +1,73 @@
+package metrics_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"mistapi/src/metrics"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func scrape(t *testing.T) string {
+	rr := httptest.NewRecorder()
+	metrics.Handler().ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, rr.Code)
+	return rr.Body.String()
+}
+
+func TestMiddleware(t *testing.T) {
+	// ARRANGE
+	r := chi.NewRouter()
+	r.Use(metrics.Middleware)
+	r.Get("/api/v1/appservers/{id}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	// ACT
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/v1/appservers/123", nil))
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/nowhere", nil))
+
+	// ASSERT
+	body := scrape(t)
+	assert.Contains(t, body, `mistapi_http_requests_total{method="GET",route="/api/v1/appservers/{id}",status="404"} 1`)
+	assert.Contains(t, body, `mistapi_http_requests_total{method="GET",route="unmatched",status="404"} 1`)
+	assert.Contains(t, body, `mistapi_http_request_duration_seconds_bucket{method="GET",route="/api/v1/appservers/{id}",status="404"`)
+	assert.Contains(t, body, "mistapi_http_requests_in_flight 0")
+}
+
+func TestUnaryClientInterceptor(t *testing.T) {
+	// ARRANGE
+	invoker := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		return status.Error(codes.Unavailable, "down")
+	}
+
+	// ACT
+	err := metrics.UnaryClientInterceptor(
+		context.Background(), "/v1.appserver.AppserverService/GetById", nil, nil, nil, invoker,
+	)
+
+	// ASSERT
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	body := scrape(t)
+	assert.Contains(t, body,
+		`mistapi_grpc_client_requests_total{code="Unavailable",method="GetById",service="v1.appserver.AppserverService"} 1`)
+}
+
+func TestRecordAuthFailure(t *testing.T) {
+	// ACT
+	metrics.RecordAuthFailure("expired")
+	metrics.RecordAuthFailure("expired")
+
+	// ASSERT
+	lines := strings.Split(scrape(t), "\n")
+	assert.Contains(t, lines, `mistapi_auth_failures_total{reason="expired"} 2`)
+}
@@ -0,0 +1,101 @@
+package permissions
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"mistapi/src/logging"
+	"mistapi/src/problem"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// Loader resolves the permissions of the caller in an appserver. When it
+// fails it writes the error response itself and returns false.
+type Loader func(w http.ResponseWriter, r *http.Request, appserverID string) (Set, bool)
+
+// AppserverID extracts the appserver a request targets, "" when missing.
+type AppserverID func(r *http.Request) string
+
+// URLParam reads the appserver from a chi route parameter.
+func URLParam(name string) AppserverID {
+	return func(r *http.Request) string {
+		return chi.URLParam(r, name)
+	}
+}
+
+// QueryParam reads the appserver from a query parameter.
+func QueryParam(name string) AppserverID {
+	return func(r *http.Request) string {
+		return r.URL.Query().Get(name)
+	}
+}
+
+type contextKey struct{}
+
+type resolved struct {
+	appserverID string
+	set         Set
+}
+
+// FromContext returns the permissions resolved by Require for the request
+// and the appserver they apply to.
+func FromContext(ctx context.Context) (Set, string, bool) {
+	res, ok := ctx.Value(contextKey{}).(resolved)
+	return res.set, res.appserverID, ok
+}
+
+// Require rejects requests whose caller lacks any of perms in the appserver
+// returned by appserverID, before they reach the backend. The resolved Set
+// is available to the handler through FromContext.
+func Require(load Loader, appserverID AppserverID, perms ...Permission) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			id := appserverID(r)
+			if id == "" {
+				problem.Error(w, r, http.StatusBadRequest, problem.BadRequest, "Missing appserver_id.")
+				return
+			}
+
+			set, resolvedID, ok := FromContext(r.Context())
+			if !ok || resolvedID != id {
+				if set, ok = load(w, r, id); !ok {
+					return
+				}
+			}
+
+			if missing := set.Missing(perms...); len(missing) > 0 {
+				names := make([]string, len(missing))
+				for i, p := range missing {
+					names[i] = p.Name
+				}
+				logging.FromContext(r.Context()).Warn("Missing permissions",
+					"appserver_id", id, "missing_permissions", strings.Join(names, ","))
+				problem.Error(w, r, http.StatusForbidden, problem.PermissionDenied,
+					"Missing permissions: "+strings.Join(names, ", ")+".")
+				return
+			}
+
+			ctx := context.WithValue(r.Context(), contextKey{}, resolved{appserverID: id, set: set})
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// RequireIfSet is Require for routes where the appserver is optional.
+// Requests without one skip the check and are left to the authorization of
+// the backend.
+func RequireIfSet(load Loader, appserverID AppserverID, perms ...Permission) func(http.Handler) http.Handler {
+	require := Require(load, appserverID, perms...)
+	return func(next http.Handler) http.Handler {
+		guarded := require(next)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if appserverID(r) == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			guarded.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package permissions_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"mistapi/src/permissions"
+	"mistapi/src/problem"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequire(t *testing.T) {
+	grantManageChannels := func(w http.ResponseWriter, r *http.Request, appserverID string) (permissions.Set, bool) {
+		return permissions.Set{Appserver: permissions.ManageChannels.Bit}, true
+	}
+
+	newRouter := func(load permissions.Loader, id permissions.AppserverID, perms ...permissions.Permission) *chi.Mux {
+		r := chi.NewRouter()
+		r.With(permissions.Require(load, id, perms...)).Post("/{id}", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		})
+		return r
+	}
+
+	t.Run("Success:granted_permissions_reach_the_handler", func(t *testing.T) {
+		// ARRANGE
+		var loadedFor string
+		load := func(w http.ResponseWriter, r *http.Request, appserverID string) (permissions.Set, bool) {
+			loadedFor = appserverID
+			return grantManageChannels(w, r, appserverID)
+		}
+		r := newRouter(load, permissions.URLParam("id"), permissions.ManageChannels)
+		rr := httptest.NewRecorder()
+
+		// ACT
+		r.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/server", nil))
+
+		// ASSERT
+		assert.Equal(t, http.StatusNoContent, rr.Code)
+		assert.Equal(t, "server", loadedFor)
+	})
+
+	t.Run("Success:appserver_is_read_from_the_query", func(t *testing.T) {
+		// ARRANGE
+		var loadedFor string
+		load := func(w http.ResponseWriter, r *http.Request, appserverID string) (permissions.Set, bool) {
+			loadedFor = appserverID
+			return grantManageChannels(w, r, appserverID)
+		}
+		r := newRouter(load, permissions.QueryParam("appserver_id"), permissions.ManageChannels)
+		rr := httptest.NewRecorder()
+
+		// ACT
+		r.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/x?appserver_id=server", nil))
+
+		// ASSERT
+		assert.Equal(t, http.StatusNoContent, rr.Code)
+		assert.Equal(t, "server", loadedFor)
+	})
+
+	t.Run("Error:missing_permissions_are_forbidden", func(t *testing.T) {
+		// ARRANGE
+		r := newRouter(grantManageChannels, permissions.URLParam("id"), permissions.ManageRoles)
+		rr := httptest.NewRecorder()
+
+		// ACT
+		r.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/server", nil))
+
+		// ASSERT
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+		assert.Equal(t, problem.ContentType, rr.Header().Get("Content-Type"))
+		assert.Contains(t, rr.Body.String(), `"code":"permission_denied"`)
+		assert.Contains(t, rr.Body.String(), "Missing permissions: manage_roles.")
+	})
+
+	t.Run("Error:request_without_appserver_is_rejected", func(t *testing.T) {
+		// ARRANGE
+		r := newRouter(grantManageChannels, permissions.URLParam("missing"), permissions.ManageChannels)
+		rr := httptest.NewRecorder()
+
+		// ACT
+		r.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/x", nil))
+
+		// ASSERT
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Error:loader_failures_stop_the_request", func(t *testing.T) {
+		// ARRANGE
+		load := func(w http.ResponseWriter, r *http.Request, appserverID string) (permissions.Set, bool) {
+			w.WriteHeader(http.StatusBadGateway)
+			return permissions.Set{}, false
+		}
+		r := newRouter(load, permissions.URLParam("id"), permissions.ManageChannels)
+		rr := httptest.NewRecorder()
+
+		// ACT
+		r.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/server", nil))
+
+		// ASSERT
+		assert.Equal(t, http.StatusBadGateway, rr.Code)
+	})
+}
+
+func TestRequireIfSet(t *testing.T) {
+	denyAll := func(w http.ResponseWriter, r *http.Request, appserverID string) (permissions.Set, bool) {
+		return permissions.Set{}, true
+	}
+
+	r := chi.NewRouter()
+	r.With(permissions.RequireIfSet(denyAll, permissions.QueryParam("appserver_id"), permissions.KickMembers)).
+		Delete("/{id}", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		})
+
+	t.Run("Success:request_without_appserver_is_let_through", func(t *testing.T) {
+		// ARRANGE
+		rr := httptest.NewRecorder()
+
+		// ACT
+		r.ServeHTTP(rr, httptest.NewRequest(http.MethodDelete, "/sub", nil))
+
+		// ASSERT
+		assert.Equal(t, http.StatusNoContent, rr.Code)
+	})
+
+	t.Run("Error:request_with_appserver_is_checked", func(t *testing.T) {
+		// ARRANGE
+		rr := httptest.NewRecorder()
+
+		// ACT
+		r.ServeHTTP(rr, httptest.NewRequest(http.MethodDelete, "/sub?appserver_id=server", nil))
+
+		// ASSERT
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+}
@@ -0,0 +1,258 @@
+// Package permissions evaluates the permission masks carried by appserver
+// roles. The bit values are shared with the backend and must not change.
+package permissions
+
+import (
+	"mistapi/src/protos/v1/appserver_role"
+	"mistapi/src/protos/v1/appserver_role_sub"
+	"mistapi/src/protos/v1/channel"
+	"mistapi/src/protos/v1/channel_role"
+)
+
+// Scope is the role mask a permission is stored in.
+type Scope int
+
+const (
+	// AppserverScope permissions live in appserver_permission_mask.
+	AppserverScope Scope = iota
+	// ChannelScope permissions live in channel_permission_mask.
+	ChannelScope
+	// SubScope permissions live in sub_permission_mask and apply to the
+	// members of the appserver.
+	SubScope
+)
+
+func (s Scope) String() string {
+	switch s {
+	case AppserverScope:
+		return "appserver"
+	case ChannelScope:
+		return "channel"
+	case SubScope:
+		return "sub"
+	default:
+		return "unknown"
+	}
+}
+
+// Permission is a single named bit of one of the role masks.
+type Permission struct {
+	Scope Scope
+	Bit   int64
+	Name  string
+}
+
+func (p Permission) String() string {
+	return p.Name
+}
+
+var (
+	ManageAppserver = Permission{AppserverScope, 1 << 0, "manage_appserver"}
+	ManageRoles     = Permission{AppserverScope, 1 << 1, "manage_roles"}
+	ManageChannels  = Permission{AppserverScope, 1 << 2, "manage_channels"}
+
+	ViewChannel    = Permission{ChannelScope, 1 << 0, "view_channel"}
+	SendMessages   = Permission{ChannelScope, 1 << 1, "send_messages"}
+	ManageMessages = Permission{ChannelScope, 1 << 2, "manage_messages"}
+
+	KickMembers = Permission{SubScope, 1 << 0, "kick_members"}
+	BanMembers  = Permission{SubScope, 1 << 1, "ban_members"}
+	AssignRoles = Permission{SubScope, 1 << 2, "assign_roles"}
+)
+
+// All lists every permission, grouped by scope in bit order.
+var All = []Permission{
+	ManageAppserver, ManageRoles, ManageChannels,
+	ViewChannel, SendMessages, ManageMessages,
+	KickMembers, BanMembers, AssignRoles,
+}
+
+// Names returns the names of the permissions of scope set in mask. Unknown
+// bits are ignored.
+func Names(scope Scope, mask int64) []string {
+	names := []string{}
+	for _, p := range All {
+		if p.Scope == scope && mask&p.Bit != 0 {
+			names = append(names, p.Name)
+		}
+	}
+	return names
+}
+
+// Lookup returns the permission of scope called name.
+func Lookup(scope Scope, name string) (Permission, bool) {
+	for _, p := range All {
+		if p.Scope == scope && p.Name == name {
+			return p, true
+		}
+	}
+	return Permission{}, false
+}
+
+// Set holds the effective permissions of a user in an appserver.
+type Set struct {
+	// Owner grants every permission.
+	Owner     bool
+	Appserver int64
+	Channel   int64
+	Sub       int64
+}
+
+// Mask returns the mask of scope.
+func (s Set) Mask(scope Scope) int64 {
+	switch scope {
+	case AppserverScope:
+		return s.Appserver
+	case ChannelScope:
+		return s.Channel
+	case SubScope:
+		return s.Sub
+	default:
+		return 0
+	}
+}
+
+// Has reports whether every permission in perms is granted.
+func (s Set) Has(perms ...Permission) bool {
+	return len(s.Missing(perms...)) == 0
+}
+
+// Missing returns the permissions of perms that are not granted.
+func (s Set) Missing(perms ...Permission) []Permission {
+	if s.Owner {
+		return nil
+	}
+
+	var missing []Permission
+	for _, p := range perms {
+		if s.Mask(p.Scope)&p.Bit == 0 {
+			missing = append(missing, p)
+		}
+	}
+	return missing
+}
+
+// Effective combines the masks of the roles userID is subscribed to. Role
+// subs of other users or appservers and subs to unknown roles are ignored.
+func Effective(
+	appserverID, userID string,
+	roleSubs []*appserver_role_sub.AppserverRoleSub,
+	roles []*appserver_role.AppserverRole,
+) Set {
+	return Combine(UserRoles(appserverID, userID, roleSubs, roles))
+}
+
+// UserRoles returns the roles of appserverID userID is subscribed to.
+func UserRoles(
+	appserverID, userID string,
+	roleSubs []*appserver_role_sub.AppserverRoleSub,
+	roles []*appserver_role.AppserverRole,
+) []*appserver_role.AppserverRole {
+	subscribed := map[string]bool{}
+	for _, sub := range roleSubs {
+		if sub.AppuserId == userID && sub.AppserverId == appserverID {
+			subscribed[sub.AppserverRoleId] = true
+		}
+	}
+
+	var res []*appserver_role.AppserverRole
+	for _, role := range roles {
+		if role.AppserverId == appserverID && subscribed[role.Id] {
+			res = append(res, role)
+		}
+	}
+	return res
+}
+
+// Combine ORs the masks of roles.
+func Combine(roles []*appserver_role.AppserverRole) Set {
+	var set Set
+	for _, role := range roles {
+		set.Appserver |= role.AppserverPermissionMask
+		set.Channel |= role.ChannelPermissionMask
+		set.Sub |= role.SubPermissionMask
+	}
+	return set
+}
+
+func roleMask(role *appserver_role.AppserverRole, scope Scope) int64 {
+	switch scope {
+	case AppserverScope:
+		return role.AppserverPermissionMask
+	case ChannelScope:
+		return role.ChannelPermissionMask
+	case SubScope:
+		return role.SubPermissionMask
+	default:
+		return 0
+	}
+}
+
+// Grant is a granted permission with the roles granting it. RoleIDs is empty
+// when the permission comes from owning the appserver.
+type Grant struct {
+	Permission Permission
+	RoleIDs    []string
+}
+
+// Grants lists the permissions of scope granted by roles, in the order of
+// All. An owner is granted every permission of scope.
+func Grants(scope Scope, owner bool, roles []*appserver_role.AppserverRole) []Grant {
+	grants := []Grant{}
+	for _, p := range All {
+		if p.Scope != scope {
+			continue
+		}
+
+		grant := Grant{Permission: p, RoleIDs: []string{}}
+		for _, role := range roles {
+			if roleMask(role, scope)&p.Bit != 0 {
+				grant.RoleIDs = append(grant.RoleIDs, role.Id)
+			}
+		}
+
+		if owner || len(grant.RoleIDs) > 0 {
+			grants = append(grants, grant)
+		}
+	}
+	return grants
+}
+
+// ChannelRoles narrows roles down to the ones applying to a channel. Every
+// role applies to a public channel, a private one is limited to the roles
+// linked to it through channel roles.
+func ChannelRoles(
+	ch *channel.Channel,
+	channelRoles []*channel_role.ChannelRole,
+	roles []*appserver_role.AppserverRole,
+) []*appserver_role.AppserverRole {
+	if !ch.IsPrivate {
+		return roles
+	}
+
+	linked := map[string]bool{}
+	for _, cr := range channelRoles {
+		if cr.ChannelId == ch.Id {
+			linked[cr.AppserverRoleId] = true
+		}
+	}
+
+	var res []*appserver_role.AppserverRole
+	for _, role := range roles {
+		if linked[role.Id] {
+			res = append(res, role)
+		}
+	}
+	return res
+}
+
+// AllMask returns the mask with every permission of scope set.
+func AllMask(scope Scope) int64 {
+	var mask int64
+	for _, p := range All {
+		if p.Scope == scope {
+			mask |= p.Bit
+		}
+	}
+	return mask
+}
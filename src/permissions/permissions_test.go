@@ -0,0 +1,155 @@
+package permissions_test
+
+import (
+	"testing"
+
+	"mistapi/src/permissions"
+	"mistapi/src/protos/v1/appserver_role"
+	"mistapi/src/protos/v1/appserver_role_sub"
+	"mistapi/src/protos/v1/channel"
+	"mistapi/src/protos/v1/channel_role"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEffective(t *testing.T) {
+	roles := []*appserver_role.AppserverRole{
+		{Id: "moderator", AppserverId: "server", SubPermissionMask: permissions.KickMembers.Bit},
+		{Id: "builder", AppserverId: "server", AppserverPermissionMask: permissions.ManageChannels.Bit,
+			ChannelPermissionMask: permissions.ManageMessages.Bit},
+		{Id: "admin", AppserverId: "server", AppserverPermissionMask: permissions.ManageRoles.Bit},
+		{Id: "foreign", AppserverId: "other-server", SubPermissionMask: permissions.BanMembers.Bit},
+	}
+
+	t.Run("Success:masks_of_the_user_roles_are_combined", func(t *testing.T) {
+		// ARRANGE
+		subs := []*appserver_role_sub.AppserverRoleSub{
+			{AppuserId: "user", AppserverId: "server", AppserverRoleId: "moderator"},
+			{AppuserId: "user", AppserverId: "server", AppserverRoleId: "builder"},
+			{AppuserId: "other-user", AppserverId: "server", AppserverRoleId: "admin"},
+			{AppuserId: "user", AppserverId: "other-server", AppserverRoleId: "foreign"},
+			{AppuserId: "user", AppserverId: "server", AppserverRoleId: "deleted"},
+		}
+
+		// ACT
+		set := permissions.Effective("server", "user", subs, roles)
+
+		// ASSERT
+		assert.True(t, set.Has(permissions.KickMembers, permissions.ManageChannels, permissions.ManageMessages))
+		assert.Equal(t,
+			[]permissions.Permission{permissions.ManageRoles, permissions.BanMembers},
+			set.Missing(permissions.ManageRoles, permissions.BanMembers, permissions.KickMembers))
+	})
+
+	t.Run("Success:user_without_roles_has_no_permissions", func(t *testing.T) {
+		// ACT
+		set := permissions.Effective("server", "user", nil, roles)
+
+		// ASSERT
+		assert.Equal(t, permissions.Set{}, set)
+		assert.False(t, set.Has(permissions.ViewChannel))
+	})
+}
+
+func TestSet(t *testing.T) {
+	t.Run("Success:owner_has_every_permission", func(t *testing.T) {
+		// ACT
+		set := permissions.Set{Owner: true}
+
+		// ASSERT
+		assert.True(t, set.Has(permissions.All...))
+	})
+
+	t.Run("Success:scopes_do_not_share_bits", func(t *testing.T) {
+		// ARRANGE
+		set := permissions.Set{Channel: permissions.ViewChannel.Bit}
+
+		// ACT
+		missing := set.Missing(permissions.ManageAppserver, permissions.KickMembers, permissions.ViewChannel)
+
+		// ASSERT
+		assert.Equal(t, []permissions.Permission{permissions.ManageAppserver, permissions.KickMembers}, missing)
+	})
+}
+
+func TestNames(t *testing.T) {
+	// ACT
+	names := permissions.Names(permissions.SubScope, permissions.KickMembers.Bit|permissions.AssignRoles.Bit|1<<40)
+
+	// ASSERT
+	assert.Equal(t, []string{"kick_members", "assign_roles"}, names)
+}
+
+func TestGrants(t *testing.T) {
+	roles := []*appserver_role.AppserverRole{
+		{Id: "builder", ChannelPermissionMask: permissions.ViewChannel.Bit | permissions.SendMessages.Bit},
+		{Id: "moderator", ChannelPermissionMask: permissions.ViewChannel.Bit | permissions.ManageMessages.Bit},
+	}
+
+	t.Run("Success:grants_list_the_granting_roles", func(t *testing.T) {
+		// ACT
+		grants := permissions.Grants(permissions.ChannelScope, false, roles)
+
+		// ASSERT
+		assert.Equal(t, []permissions.Grant{
+			{Permission: permissions.ViewChannel, RoleIDs: []string{"builder", "moderator"}},
+			{Permission: permissions.SendMessages, RoleIDs: []string{"builder"}},
+			{Permission: permissions.ManageMessages, RoleIDs: []string{"moderator"}},
+		}, grants)
+	})
+
+	t.Run("Success:owner_is_granted_every_permission_of_the_scope", func(t *testing.T) {
+		// ACT
+		grants := permissions.Grants(permissions.AppserverScope, true, nil)
+
+		// ASSERT
+		assert.Equal(t, []permissions.Grant{
+			{Permission: permissions.ManageAppserver, RoleIDs: []string{}},
+			{Permission: permissions.ManageRoles, RoleIDs: []string{}},
+			{Permission: permissions.ManageChannels, RoleIDs: []string{}},
+		}, grants)
+	})
+}
+
+func TestChannelRoles(t *testing.T) {
+	roles := []*appserver_role.AppserverRole{{Id: "builder"}, {Id: "moderator"}}
+	channelRoles := []*channel_role.ChannelRole{
+		{ChannelId: "private", AppserverRoleId: "moderator"},
+		{ChannelId: "other", AppserverRoleId: "builder"},
+	}
+
+	t.Run("Success:every_role_applies_to_a_public_channel", func(t *testing.T) {
+		// ACT
+		res := permissions.ChannelRoles(&channel.Channel{Id: "public"}, channelRoles, roles)
+
+		// ASSERT
+		assert.Equal(t, roles, res)
+	})
+
+	t.Run("Success:private_channel_is_limited_to_linked_roles", func(t *testing.T) {
+		// ACT
+		res := permissions.ChannelRoles(&channel.Channel{Id: "private", IsPrivate: true}, channelRoles, roles)
+
+		// ASSERT
+		assert.Equal(t, []*appserver_role.AppserverRole{roles[1]}, res)
+	})
+}
+
+func TestLookup(t *testing.T) {
+	t.Run("Success:permission_is_found_in_its_scope", func(t *testing.T) {
+		// ACT
+		p, ok := permissions.Lookup(permissions.SubScope, "ban_members")
+
+		// ASSERT
+		assert.True(t, ok)
+		assert.Equal(t, permissions.BanMembers, p)
+	})
+
+	t.Run("Error:permission_of_another_scope_is_not_found", func(t *testing.T) {
+		// ACT
+		_, ok := permissions.Lookup(permissions.AppserverScope, "ban_members")
+
+		// ASSERT
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,168 @@
+// Package problem writes the errors of the API as RFC 7807 problem details.
+// Every error carries a stable code clients can switch on; titles and
+// details are meant for humans and may change.
+package problem
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	"github.com/go-chi/chi/v5/middleware"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
+)
+
+// ContentType is the media type of problem responses.
+const ContentType = "application/problem+json"
+
+// Code identifies a kind of problem. Codes are part of the API, existing
+// ones must not change.
+type Code string
+
+const (
+	BadRequest           Code = "bad_request"
+	ValidationFailed     Code = "validation_failed"
+	InvalidBody          Code = "invalid_body"
+	MalformedBody        Code = "malformed_body"
+	PayloadTooLarge      Code = "payload_too_large"
+	UnsupportedMediaType Code = "unsupported_media_type"
+	Unauthenticated      Code = "unauthenticated"
+	PermissionDenied     Code = "permission_denied"
+	NotFound             Code = "not_found"
+	AlreadyExists        Code = "already_exists"
+	PreconditionFailed   Code = "precondition_failed"
+	BackendUnavailable   Code = "backend_unavailable"
+	BackendTimeout       Code = "backend_timeout"
+	BackendError         Code = "backend_error"
+	ServiceUnavailable   Code = "service_unavailable"
+	Internal             Code = "internal"
+)
+
+var titles = map[Code]string{
+	BadRequest:           "Bad request",
+	ValidationFailed:     "Validation failed",
+	InvalidBody:          "Invalid request body",
+	MalformedBody:        "Malformed request body",
+	PayloadTooLarge:      "Payload too large",
+	UnsupportedMediaType: "Unsupported media type",
+	Unauthenticated:      "Unauthenticated",
+	PermissionDenied:     "Permission denied",
+	NotFound:             "Not found",
+	AlreadyExists:        "Already exists",
+	PreconditionFailed:   "Precondition failed",
+	BackendUnavailable:   "Backend unavailable",
+	BackendTimeout:       "Backend timeout",
+	BackendError:         "Backend error",
+	ServiceUnavailable:   "Service unavailable",
+	Internal:             "Internal server error",
+}
+
+// Type returns the type URI of the problem.
+func (c Code) Type() string {
+	return "urn:mist:problem:" + string(c)
+}
+
+// Title returns the summary shared by every problem of the code.
+func (c Code) Title() string {
+	if title, ok := titles[c]; ok {
+		return title
+	}
+	return string(c)
+}
+
+// FieldError points at the request field that failed validation.
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// Problem is a problem details object.
+type Problem struct {
+	Type      string       `json:"type"`
+	Title     string       `json:"title"`
+	Status    int          `json:"status"`
+	Code      Code         `json:"code"`
+	Detail    string       `json:"detail,omitempty"`
+	RequestID string       `json:"request_id,omitempty"`
+	Errors    []FieldError `json:"errors,omitempty"`
+}
+
+func New(status int, code Code, detail string) *Problem {
+	return &Problem{
+		Type:   code.Type(),
+		Title:  code.Title(),
+		Status: status,
+		Code:   code,
+		Detail: detail,
+	}
+}
+
+// Write sends p with the ID of the request, so users can quote it when
+// reporting the problem.
+func Write(w http.ResponseWriter, r *http.Request, p *Problem) {
+	p.RequestID = middleware.GetReqID(r.Context())
+
+	w.Header().Set("Content-Type", ContentType)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(p.Status)
+	json.NewEncoder(w).Encode(p)
+}
+
+// Error writes a problem without field errors.
+func Error(w http.ResponseWriter, r *http.Request, status int, code Code, detail string) {
+	Write(w, r, New(status, code, detail))
+}
+
+// FromStatus returns the field violations carried by the details of a gRPC
+// status, as google.rpc.BadRequest or protovalidate violations.
+func FromStatus(s *status.Status) []FieldError {
+	var errs []FieldError
+	for _, detail := range s.Details() {
+		switch d := detail.(type) {
+		case *errdetails.BadRequest:
+			for _, v := range d.FieldViolations {
+				errs = append(errs, FieldError{Field: v.Field, Message: v.Description})
+			}
+		case *validate.Violations:
+			errs = append(errs, Violations(d.Violations)...)
+		}
+	}
+	return errs
+}
+
+// Violations converts protovalidate violations.
+func Violations(violations []*validate.Violation) []FieldError {
+	errs := make([]FieldError, 0, len(violations))
+	for _, v := range violations {
+		errs = append(errs, FieldError{Field: FieldPath(v.GetField()), Message: v.GetMessage()})
+	}
+	return errs
+}
+
+// FieldPath formats a protovalidate field path like a.b[0]["key"].
+func FieldPath(path *validate.FieldPath) string {
+	var b strings.Builder
+	for _, e := range path.GetElements() {
+		if b.Len() > 0 {
+			b.WriteByte('.')
+		}
+		b.WriteString(e.GetFieldName())
+
+		switch s := e.GetSubscript().(type) {
+		case *validate.FieldPathElement_Index:
+			fmt.Fprintf(&b, "[%d]", s.Index)
+		case *validate.FieldPathElement_BoolKey:
+			fmt.Fprintf(&b, "[%t]", s.BoolKey)
+		case *validate.FieldPathElement_IntKey:
+			fmt.Fprintf(&b, "[%d]", s.IntKey)
+		case *validate.FieldPathElement_UintKey:
+			fmt.Fprintf(&b, "[%d]", s.UintKey)
+		case *validate.FieldPathElement_StringKey:
+			fmt.Fprintf(&b, "[%q]", s.StringKey)
+		}
+	}
+	return b.String()
+}
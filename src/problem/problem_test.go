@@ -0,0 +1,80 @@
+package problem_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"mistapi/src/problem"
+
+	"buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestWrite(t *testing.T) {
+	t.Run("Success:problem_is_written_with_the_request_id", func(t *testing.T) {
+		// ARRANGE
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r = r.WithContext(context.WithValue(r.Context(), middleware.RequestIDKey, "req-1"))
+		p := problem.New(http.StatusNotFound, problem.NotFound, "Not found.")
+
+		// ACT
+		problem.Write(w, r, p)
+
+		// ASSERT
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Equal(t, problem.ContentType, w.Header().Get("Content-Type"))
+		assert.JSONEq(t, `{
+			"type": "urn:mist:problem:not_found",
+			"title": "Not found",
+			"status": 404,
+			"code": "not_found",
+			"detail": "Not found.",
+			"request_id": "req-1"
+		}`, w.Body.String())
+	})
+}
+
+func TestFromStatus(t *testing.T) {
+	t.Run("Success:bad_request_and_protovalidate_details", func(t *testing.T) {
+		// ARRANGE
+		s, err := status.New(codes.InvalidArgument, "invalid").WithDetails(
+			&errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: "name", Description: "is required"},
+			}},
+			&validate.Violations{Violations: []*validate.Violation{{
+				Field: &validate.FieldPath{Elements: []*validate.FieldPathElement{
+					{FieldName: proto.String("roles"), Subscript: &validate.FieldPathElement_Index{Index: 1}},
+					{FieldName: proto.String("labels"), Subscript: &validate.FieldPathElement_StringKey{StringKey: "a"}},
+				}},
+				Message: proto.String("is too long"),
+			}}},
+		)
+		require.NoError(t, err)
+
+		// ACT
+		errs := problem.FromStatus(s)
+
+		// ASSERT
+		assert.Equal(t, []problem.FieldError{
+			{Field: "name", Message: "is required"},
+			{Field: `roles[1].labels["a"]`, Message: "is too long"},
+		}, errs)
+	})
+
+	t.Run("Success:status_without_details", func(t *testing.T) {
+		// ACT
+		errs := problem.FromStatus(status.New(codes.InvalidArgument, "invalid"))
+
+		// ASSERT
+		assert.Empty(t, errs)
+	})
+}
@@ -21,6 +21,7 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	AppserverRoleService_Create_FullMethodName          = "/v1.appserver_role.AppserverRoleService/Create"
 	AppserverRoleService_ListServerRoles_FullMethodName = "/v1.appserver_role.AppserverRoleService/ListServerRoles"
+	AppserverRoleService_Update_FullMethodName          = "/v1.appserver_role.AppserverRoleService/Update"
 	AppserverRoleService_Delete_FullMethodName          = "/v1.appserver_role.AppserverRoleService/Delete"
 )
 
@@ -30,6 +31,7 @@ const (
 type AppserverRoleServiceClient interface {
 	Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error)
 	ListServerRoles(ctx context.Context, in *ListServerRolesRequest, opts ...grpc.CallOption) (*ListServerRolesResponse, error)
+	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
 	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
 }
 
@@ -61,6 +63,16 @@ func (c *appserverRoleServiceClient) ListServerRoles(ctx context.Context, in *Li
 	return out, nil
 }
 
+func (c *appserverRoleServiceClient) Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateResponse)
+	err := c.cc.Invoke(ctx, AppserverRoleService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *appserverRoleServiceClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteResponse)
@@ -77,6 +89,7 @@ func (c *appserverRoleServiceClient) Delete(ctx context.Context, in *DeleteReque
 type AppserverRoleServiceServer interface {
 	Create(context.Context, *CreateRequest) (*CreateResponse, error)
 	ListServerRoles(context.Context, *ListServerRolesRequest) (*ListServerRolesResponse, error)
+	Update(context.Context, *UpdateRequest) (*UpdateResponse, error)
 	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
 	mustEmbedUnimplementedAppserverRoleServiceServer()
 }
@@ -94,6 +107,9 @@ func (UnimplementedAppserverRoleServiceServer) Create(context.Context, *CreateRe
 func (UnimplementedAppserverRoleServiceServer) ListServerRoles(context.Context, *ListServerRolesRequest) (*ListServerRolesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListServerRoles not implemented")
 }
+func (UnimplementedAppserverRoleServiceServer) Update(context.Context, *UpdateRequest) (*UpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
 func (UnimplementedAppserverRoleServiceServer) Delete(context.Context, *DeleteRequest) (*DeleteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
@@ -154,6 +170,24 @@ func _AppserverRoleService_ListServerRoles_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AppserverRoleService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppserverRoleServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppserverRoleService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppserverRoleServiceServer).Update(ctx, req.(*UpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AppserverRoleService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteRequest)
 	if err := dec(in); err != nil {
@@ -187,6 +221,10 @@ var AppserverRoleService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListServerRoles",
 			Handler:    _AppserverRoleService_ListServerRoles_Handler,
 		},
+		{
+			MethodName: "Update",
+			Handler:    _AppserverRoleService_Update_Handler,
+		},
 		{
 			MethodName: "Delete",
 			Handler:    _AppserverRoleService_Delete_Handler,
@@ -22,6 +22,7 @@ const (
 	ChannelService_Create_FullMethodName             = "/v1.channel.ChannelService/Create"
 	ChannelService_GetById_FullMethodName            = "/v1.channel.ChannelService/GetById"
 	ChannelService_ListServerChannels_FullMethodName = "/v1.channel.ChannelService/ListServerChannels"
+	ChannelService_Update_FullMethodName             = "/v1.channel.ChannelService/Update"
 	ChannelService_Delete_FullMethodName             = "/v1.channel.ChannelService/Delete"
 )
 
@@ -32,6 +33,7 @@ type ChannelServiceClient interface {
 	Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error)
 	GetById(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*GetByIdResponse, error)
 	ListServerChannels(ctx context.Context, in *ListServerChannelsRequest, opts ...grpc.CallOption) (*ListServerChannelsResponse, error)
+	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
 	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
 }
 
@@ -73,6 +75,16 @@ func (c *channelServiceClient) ListServerChannels(ctx context.Context, in *ListS
 	return out, nil
 }
 
+func (c *channelServiceClient) Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateResponse)
+	err := c.cc.Invoke(ctx, ChannelService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *channelServiceClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteResponse)
@@ -90,6 +102,7 @@ type ChannelServiceServer interface {
 	Create(context.Context, *CreateRequest) (*CreateResponse, error)
 	GetById(context.Context, *GetByIdRequest) (*GetByIdResponse, error)
 	ListServerChannels(context.Context, *ListServerChannelsRequest) (*ListServerChannelsResponse, error)
+	Update(context.Context, *UpdateRequest) (*UpdateResponse, error)
 	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
 	mustEmbedUnimplementedChannelServiceServer()
 }
@@ -110,6 +123,9 @@ func (UnimplementedChannelServiceServer) GetById(context.Context, *GetByIdReques
 func (UnimplementedChannelServiceServer) ListServerChannels(context.Context, *ListServerChannelsRequest) (*ListServerChannelsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListServerChannels not implemented")
 }
+func (UnimplementedChannelServiceServer) Update(context.Context, *UpdateRequest) (*UpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
 func (UnimplementedChannelServiceServer) Delete(context.Context, *DeleteRequest) (*DeleteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
@@ -188,6 +204,24 @@ func _ChannelService_ListServerChannels_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChannelService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).Update(ctx, req.(*UpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ChannelService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteRequest)
 	if err := dec(in); err != nil {
@@ -225,6 +259,10 @@ var ChannelService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListServerChannels",
 			Handler:    _ChannelService_ListServerChannels_Handler,
 		},
+		{
+			MethodName: "Update",
+			Handler:    _ChannelService_Update_Handler,
+		},
 		{
 			MethodName: "Delete",
 			Handler:    _ChannelService_Delete_Handler,
@@ -0,0 +1,171 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"mistapi/src/config"
+	"mistapi/src/metrics"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrCircuitOpen is returned without calling the backend while the circuit of
+// the called service is open. It compares equal with errors.Is.
+var ErrCircuitOpen = status.Error(codes.Unavailable, "circuit breaker is open")
+
+type circuitState int
+
+const (
+	circuitClosed circuitState = iota
+	circuitHalfOpen
+	circuitOpen
+)
+
+func (s circuitState) String() string {
+	switch s {
+	case circuitClosed:
+		return "closed"
+	case circuitHalfOpen:
+		return "half-open"
+	default:
+		return "open"
+	}
+}
+
+// circuitBreaker opens after a run of consecutive backend failures, fails
+// calls fast while open, and lets a single probe through once OpenTimeout has
+// passed. The probe outcome closes or re-opens the circuit.
+type circuitBreaker struct {
+	service string
+	cfg     config.BreakerConfig
+	now     func() time.Time
+
+	mu       sync.Mutex
+	state    circuitState
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+func newCircuitBreaker(service string, cfg config.BreakerConfig) *circuitBreaker {
+	b := &circuitBreaker{service: service, cfg: cfg, now: time.Now}
+	metrics.SetCircuitState(service, int(circuitClosed))
+	return b
+}
+
+// allow reports whether a call may go through and whether it is the probe of
+// a half-open circuit. An allowed call must be followed by done.
+func (b *circuitBreaker) allow() (ok bool, probe bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case circuitOpen:
+		if b.now().Sub(b.openedAt) < b.cfg.OpenTimeout {
+			return false, false
+		}
+		b.setState(circuitHalfOpen)
+		fallthrough
+	case circuitHalfOpen:
+		if b.probing {
+			return false, false
+		}
+		b.probing = true
+		return true, true
+	}
+	return true, false
+}
+
+// done records the outcome of an allowed call made with ctx.
+func (b *circuitBreaker) done(ctx context.Context, probe bool, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if probe {
+		b.probing = false
+	}
+
+	switch {
+	case ctx.Err() != nil || status.Code(err) == codes.Canceled:
+		// the caller gave up or ran out of its own budget, which clients
+		// choose with X-Request-Timeout; this says nothing about the backend
+	case isBackendFailure(err):
+		b.failures++
+		if probe || (b.state == circuitClosed && b.failures >= b.cfg.FailureThreshold) {
+			b.openedAt = b.now()
+			b.setState(circuitOpen)
+		}
+	default:
+		b.failures = 0
+		if probe {
+			b.setState(circuitClosed)
+		}
+	}
+}
+
+func (b *circuitBreaker) setState(state circuitState) {
+	if b.state == state {
+		return
+	}
+	b.state = state
+	metrics.SetCircuitState(b.service, int(state))
+}
+
+// isBackendFailure reports whether err means the backend could not serve the
+// call. Errors returned by the backend itself, like NotFound, do not count.
+// DeadlineExceeded only gets here when the deadline of the caller has not
+// passed, e.g. one set by the backend for its own calls.
+func isBackendFailure(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
+
+// NewCircuitBreakerInterceptor returns a client interceptor keeping one
+// circuit breaker per backend service. Calls to a service whose circuit is
+// open fail with ErrCircuitOpen. A zero FailureThreshold disables it.
+func NewCircuitBreakerInterceptor(cfg config.BreakerConfig) grpc.UnaryClientInterceptor {
+	var (
+		mu       sync.Mutex
+		breakers = map[string]*circuitBreaker{}
+	)
+
+	breakerFor := func(service string) *circuitBreaker {
+		mu.Lock()
+		defer mu.Unlock()
+		b, ok := breakers[service]
+		if !ok {
+			b = newCircuitBreaker(service, cfg)
+			breakers[service] = b
+		}
+		return b
+	}
+
+	return func(
+		ctx context.Context, method string, req, reply any,
+		cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption,
+	) error {
+		if cfg.FailureThreshold <= 0 {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		service, _ := splitFullMethod(method)
+		b := breakerFor(service)
+		ok, probe := b.allow()
+		if !ok {
+			metrics.RecordCircuitRejection(method)
+			return ErrCircuitOpen
+		}
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		b.done(ctx, probe, err)
+		return err
+	}
+}
@@ -0,0 +1,173 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"mistapi/src/config"
+	"mistapi/src/metrics"
+	"mistapi/src/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeInvoker returns the queued errors in order and counts the calls that
+// reached it.
+type fakeInvoker struct {
+	errs  []error
+	calls int
+}
+
+func (f *fakeInvoker) invoke(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+	f.calls++
+	if len(f.errs) == 0 {
+		return nil
+	}
+	err := f.errs[0]
+	f.errs = f.errs[1:]
+	return err
+}
+
+func scrapeMetrics(t *testing.T) string {
+	rr := httptest.NewRecorder()
+	metrics.Handler().ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, rr.Code)
+	return rr.Body.String()
+}
+
+func TestCircuitBreakerInterceptor(t *testing.T) {
+	unavailable := status.Error(codes.Unavailable, "down")
+	cfg := config.BreakerConfig{FailureThreshold: 2, OpenTimeout: 50 * time.Millisecond}
+	ctx := context.Background()
+
+	t.Run("Error:consecutive_failures_open_the_circuit", func(t *testing.T) {
+		// ARRANGE
+		interceptor := service.NewCircuitBreakerInterceptor(cfg)
+		inv := &fakeInvoker{errs: []error{unavailable, unavailable}}
+		method := "/v1.open.OpenService/GetById"
+
+		// ACT
+		interceptor(ctx, method, nil, nil, nil, inv.invoke)
+		interceptor(ctx, method, nil, nil, nil, inv.invoke)
+		err := interceptor(ctx, method, nil, nil, nil, inv.invoke)
+
+		// ASSERT
+		assert.True(t, errors.Is(err, service.ErrCircuitOpen))
+		assert.Equal(t, 2, inv.calls)
+		body := scrapeMetrics(t)
+		assert.Contains(t, body, `mistapi_grpc_client_circuit_state{service="v1.open.OpenService"} 2`)
+		assert.Contains(t, body,
+			`mistapi_grpc_client_circuit_rejections_total{method="GetById",service="v1.open.OpenService"} 1`)
+	})
+
+	t.Run("Success:services_have_their_own_circuit", func(t *testing.T) {
+		// ARRANGE
+		interceptor := service.NewCircuitBreakerInterceptor(cfg)
+		inv := &fakeInvoker{errs: []error{unavailable, unavailable}}
+		interceptor(ctx, "/v1.a.AService/List", nil, nil, nil, inv.invoke)
+		interceptor(ctx, "/v1.a.AService/List", nil, nil, nil, inv.invoke)
+
+		// ACT
+		err := interceptor(ctx, "/v1.b.BService/List", nil, nil, nil, inv.invoke)
+
+		// ASSERT
+		assert.NoError(t, err)
+		assert.Equal(t, 3, inv.calls)
+	})
+
+	t.Run("Success:backend_errors_do_not_open_the_circuit", func(t *testing.T) {
+		// ARRANGE
+		interceptor := service.NewCircuitBreakerInterceptor(cfg)
+		notFound := status.Error(codes.NotFound, "missing")
+		inv := &fakeInvoker{errs: []error{notFound, notFound, notFound}}
+		method := "/v1.notfound.NotFoundService/GetById"
+
+		// ACT
+		interceptor(ctx, method, nil, nil, nil, inv.invoke)
+		interceptor(ctx, method, nil, nil, nil, inv.invoke)
+		err := interceptor(ctx, method, nil, nil, nil, inv.invoke)
+
+		// ASSERT
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Equal(t, 3, inv.calls)
+	})
+
+	t.Run("Success:successful_probe_closes_the_circuit", func(t *testing.T) {
+		// ARRANGE
+		interceptor := service.NewCircuitBreakerInterceptor(cfg)
+		inv := &fakeInvoker{errs: []error{unavailable, unavailable}}
+		method := "/v1.probe.ProbeService/GetById"
+		interceptor(ctx, method, nil, nil, nil, inv.invoke)
+		interceptor(ctx, method, nil, nil, nil, inv.invoke)
+		time.Sleep(cfg.OpenTimeout)
+
+		// ACT
+		probeErr := interceptor(ctx, method, nil, nil, nil, inv.invoke)
+		err := interceptor(ctx, method, nil, nil, nil, inv.invoke)
+
+		// ASSERT
+		assert.NoError(t, probeErr)
+		assert.NoError(t, err)
+		assert.Equal(t, 4, inv.calls)
+	})
+
+	t.Run("Error:failed_probe_reopens_the_circuit", func(t *testing.T) {
+		// ARRANGE
+		interceptor := service.NewCircuitBreakerInterceptor(cfg)
+		inv := &fakeInvoker{errs: []error{unavailable, unavailable, unavailable}}
+		method := "/v1.reopen.ReopenService/GetById"
+		interceptor(ctx, method, nil, nil, nil, inv.invoke)
+		interceptor(ctx, method, nil, nil, nil, inv.invoke)
+		time.Sleep(cfg.OpenTimeout)
+
+		// ACT
+		probeErr := interceptor(ctx, method, nil, nil, nil, inv.invoke)
+		err := interceptor(ctx, method, nil, nil, nil, inv.invoke)
+
+		// ASSERT
+		assert.Equal(t, codes.Unavailable, status.Code(probeErr))
+		assert.True(t, errors.Is(err, service.ErrCircuitOpen))
+		assert.Equal(t, 3, inv.calls)
+	})
+
+	t.Run("Success:zero_threshold_disables_the_breaker", func(t *testing.T) {
+		// ARRANGE
+		interceptor := service.NewCircuitBreakerInterceptor(config.BreakerConfig{})
+		inv := &fakeInvoker{errs: []error{unavailable, unavailable, unavailable}}
+
+		// ACT
+		for range 3 {
+			interceptor(ctx, "/v1.off.OffService/List", nil, nil, nil, inv.invoke)
+		}
+
+		// ASSERT
+		assert.Equal(t, 3, inv.calls)
+	})
+	t.Run("Success:expired_caller_deadlines_do_not_open_the_circuit", func(t *testing.T) {
+		// ARRANGE
+		interceptor := service.NewCircuitBreakerInterceptor(cfg)
+		exceeded := status.Error(codes.DeadlineExceeded, "context deadline exceeded")
+		inv := &fakeInvoker{errs: []error{exceeded, exceeded, exceeded}}
+		method := "/v1.deadline.DeadlineService/GetById"
+		expired, cancel := context.WithTimeout(ctx, time.Millisecond)
+		defer cancel()
+		<-expired.Done()
+
+		// ACT
+		interceptor(expired, method, nil, nil, nil, inv.invoke)
+		interceptor(expired, method, nil, nil, nil, inv.invoke)
+		err := interceptor(expired, method, nil, nil, nil, inv.invoke)
+
+		// ASSERT
+		assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+		assert.Equal(t, 3, inv.calls)
+	})
+}
@@ -3,15 +3,16 @@ package service
 import (
 	"context"
 	"fmt"
-	"log"
-	"os"
 	"sync"
 	"time"
 
+	"github.com/go-chi/chi/v5/middleware"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
 
+	"mistapi/src/config"
+	"mistapi/src/metrics"
 	"mistapi/src/protos/v1/appserver"
 	"mistapi/src/protos/v1/appserver_role"
 	"mistapi/src/protos/v1/appserver_role_sub"
@@ -37,7 +38,9 @@ type Client struct {
 
 var (
 	conn     *grpc.ClientConn
+	connErr  error
 	connOnce sync.Once
+	stopTLS  = func() {}
 )
 
 func (c Client) GetAppserverClient() appserver.AppserverServiceClient {
@@ -68,36 +71,62 @@ func (c Client) GetChannelRoleClient() channel_role.ChannelRoleServiceClient {
 	return channel_role.NewChannelRoleServiceClient(c.Conn)
 }
 
-func SetupGrpcHeaders(jwtT string) (context.Context, context.CancelFunc) {
-	// TODO: replace timeout in the future
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+// SetupGrpcHeaders derives the context of a backend call from parent, so the
+// call is cancelled together with the originating request, and bounds it by
+// timeout.
+func SetupGrpcHeaders(parent context.Context, jwtT string, timeout time.Duration) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithTimeout(parent, timeout)
 	grpcMetadata := metadata.Pairs(
 		"authorization", fmt.Sprintf("Bearer %s", jwtT),
 	)
 
+	if requestID := middleware.GetReqID(parent); requestID != "" {
+		grpcMetadata.Set("x-request-id", requestID)
+	}
+
 	ctx = metadata.NewOutgoingContext(ctx, grpcMetadata)
 	return ctx, cancel
 }
 
-func GetGrpcClientConnection() *grpc.ClientConn {
+// GetGrpcClientConnection creates the shared backend connection on the first
+// call and returns it on the following ones.
+func GetGrpcClientConnection(cfg config.BackendConfig) (*grpc.ClientConn, error) {
 	connOnce.Do(func() {
-		var err error
-		conn, err = grpc.NewClient(
-			os.Getenv("MIST_BACKEND_APP_URL"),
-			grpc.WithTransportCredentials(insecure.NewCredentials()),
-		)
+		creds, stop, err := TransportCredentials(cfg.TLS, cfg.URL)
 		if err != nil {
-			log.Panicf("Error communicating with backend service: %v", err)
+			connErr = fmt.Errorf("setting up backend transport security: %w", err)
+			return
+		}
+
+		conn, connErr = grpc.NewClient(
+			cfg.URL,
+			grpc.WithTransportCredentials(creds),
+			grpc.WithDefaultServiceConfig(ServiceConfig(cfg.Retry)),
+			// the breaker sits in front of the metrics interceptor, so calls it
+			// rejects are only counted as rejections
+			grpc.WithChainUnaryInterceptor(
+				loggingInterceptor,
+				NewCircuitBreakerInterceptor(cfg.Breaker),
+				metrics.UnaryClientInterceptor,
+			),
+			grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+		)
+		if connErr != nil {
+			stop()
+			connErr = fmt.Errorf("creating backend connection: %w", connErr)
+			return
 		}
+		stopTLS = stop
 	})
-	return conn
+	return conn, connErr
 }
 
 var NewGrpcClient = func() GrpcClient {
-	return Client{Conn: GetGrpcClientConnection()}
+	return Client{Conn: conn}
 }
 
 func CloseGrpcConnection() {
+	stopTLS()
 	if conn != nil {
 		_ = conn.Close()
 	}
@@ -1,11 +1,14 @@
 package service_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"mistapi/src/service"
 
+	"github.com/go-chi/chi/v5/middleware"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
@@ -112,14 +115,40 @@ func TestGetChannelRoleClient(t *testing.T) {
 }
 
 func TestSetupGrpcHeaders(t *testing.T) {
-	jwt := "test-jwt-token"
-	ctx, cancel := service.SetupGrpcHeaders(jwt)
-	defer cancel()
-
-	md, ok := metadata.FromOutgoingContext(ctx)
-	require.True(t, ok, "expected metadata to be in outgoing context")
-
-	authHeader := md.Get("authorization")
-	require.Len(t, authHeader, 1, "expected one authorization header")
-	assert.Equal(t, fmt.Sprintf("Bearer %s", jwt), authHeader[0])
+	t.Run("Success:sets_authorization_metadata_and_deadline", func(t *testing.T) {
+		jwt := "test-jwt-token"
+		ctx, cancel := service.SetupGrpcHeaders(context.Background(), jwt, 2*time.Second)
+		defer cancel()
+
+		md, ok := metadata.FromOutgoingContext(ctx)
+		require.True(t, ok, "expected metadata to be in outgoing context")
+
+		authHeader := md.Get("authorization")
+		require.Len(t, authHeader, 1, "expected one authorization header")
+		assert.Equal(t, fmt.Sprintf("Bearer %s", jwt), authHeader[0])
+
+		deadline, ok := ctx.Deadline()
+		require.True(t, ok, "expected a deadline")
+		assert.WithinDuration(t, time.Now().Add(2*time.Second), deadline, 100*time.Millisecond)
+	})
+
+	t.Run("Success:forwards_request_id", func(t *testing.T) {
+		parent := context.WithValue(context.Background(), middleware.RequestIDKey, "req-123")
+		ctx, cancel := service.SetupGrpcHeaders(parent, "test-jwt-token", time.Second)
+		defer cancel()
+
+		md, _ := metadata.FromOutgoingContext(ctx)
+		assert.Equal(t, []string{"req-123"}, md.Get("x-request-id"))
+	})
+
+	t.Run("Success:cancelling_the_parent_cancels_the_call_context", func(t *testing.T) {
+		parent, cancelParent := context.WithCancel(context.Background())
+		ctx, cancel := service.SetupGrpcHeaders(parent, "test-jwt-token", time.Minute)
+		defer cancel()
+
+		cancelParent()
+
+		<-ctx.Done()
+		assert.ErrorIs(t, ctx.Err(), context.Canceled)
+	})
 }
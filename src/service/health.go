@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/connectivity"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// CheckBackendHealth reports the connectivity state of the backend connection
+// and fails unless the backend answers the standard grpc.health.v1 check with
+// SERVING.
+func CheckBackendHealth(ctx context.Context) (connectivity.State, error) {
+	if conn == nil {
+		return connectivity.Shutdown, fmt.Errorf("backend connection is not initialized")
+	}
+
+	state := conn.GetState()
+	switch state {
+	case connectivity.Idle:
+		// the connection is lazy, kick it so the check below has a chance
+		conn.Connect()
+	case connectivity.TransientFailure, connectivity.Shutdown:
+		return state, fmt.Errorf("backend connection is %s", state)
+	}
+
+	res, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+	if err != nil {
+		return conn.GetState(), err
+	}
+
+	if res.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+		return conn.GetState(), fmt.Errorf("backend is %s", res.GetStatus())
+	}
+
+	return conn.GetState(), nil
+}
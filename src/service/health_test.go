@@ -0,0 +1,62 @@
+package service_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"mistapi/src/config"
+	"mistapi/src/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestCheckBackendHealth(t *testing.T) {
+	// ARRANGE
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	healthServer := health.NewServer()
+	srv := grpc.NewServer()
+	healthpb.RegisterHealthServer(srv, healthServer)
+	go srv.Serve(ln)
+	t.Cleanup(srv.Stop)
+
+	_, err = service.GetGrpcClientConnection(config.BackendConfig{
+		URL: ln.Addr().String(),
+		TLS: config.BackendTLSConfig{Insecure: true},
+	})
+	require.NoError(t, err)
+	t.Cleanup(service.CloseGrpcConnection)
+
+	t.Run("Success:serving_backend_is_healthy", func(t *testing.T) {
+		// ACT
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		state, err := service.CheckBackendHealth(ctx)
+
+		// ASSERT
+		assert.NoError(t, err)
+		assert.Equal(t, connectivity.Ready, state)
+	})
+
+	t.Run("Error:not_serving_backend_is_unhealthy", func(t *testing.T) {
+		// ARRANGE
+		healthServer.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+
+		// ACT
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_, err := service.CheckBackendHealth(ctx)
+
+		// ASSERT
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "NOT_SERVING")
+	})
+}
@@ -0,0 +1,35 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"mistapi/src/logging"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// loggingInterceptor logs every backend call with the request scoped logger
+// found in ctx, so gateway and backend failures can be tied to a request.
+func loggingInterceptor(
+	ctx context.Context, method string, req, reply any,
+	cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption,
+) error {
+	start := time.Now()
+	err := invoker(ctx, method, req, reply, cc, opts...)
+
+	logger := logging.FromContext(ctx).With(
+		"grpc_method", method,
+		"grpc_code", status.Code(err).String(),
+		"duration", time.Since(start),
+	)
+
+	if err != nil {
+		logger.WarnContext(ctx, "Backend call failed", "error", err)
+	} else {
+		logger.DebugContext(ctx, "Backend call succeeded")
+	}
+
+	return err
+}
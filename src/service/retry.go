@@ -0,0 +1,97 @@
+package service
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"mistapi/src/config"
+	"mistapi/src/protos/v1/appserver"
+	"mistapi/src/protos/v1/appserver_role"
+	"mistapi/src/protos/v1/appserver_role_sub"
+	"mistapi/src/protos/v1/appserver_sub"
+	"mistapi/src/protos/v1/channel"
+	"mistapi/src/protos/v1/channel_role"
+)
+
+// idempotentMethods are the backend RPCs that are safe to retry. Create and
+// Delete are left out on purpose: a retry after a lost response could apply
+// the mutation twice or turn a success into NotFound.
+var idempotentMethods = []string{
+	appserver.AppserverService_GetById_FullMethodName,
+	appserver.AppserverService_List_FullMethodName,
+	appserver_role.AppserverRoleService_ListServerRoles_FullMethodName,
+	appserver_role_sub.AppserverRoleSubService_ListServerRoleSubs_FullMethodName,
+	appserver_sub.AppserverSubService_ListUserServerSubs_FullMethodName,
+	appserver_sub.AppserverSubService_ListAppserverUserSubs_FullMethodName,
+	channel.ChannelService_GetById_FullMethodName,
+	channel.ChannelService_ListServerChannels_FullMethodName,
+	channel_role.ChannelRoleService_ListChannelRoles_FullMethodName,
+}
+
+type methodName struct {
+	Service string `json:"service"`
+	Method  string `json:"method"`
+}
+
+type retryPolicy struct {
+	MaxAttempts          int      `json:"maxAttempts"`
+	InitialBackoff       string   `json:"initialBackoff"`
+	MaxBackoff           string   `json:"maxBackoff"`
+	BackoffMultiplier    float64  `json:"backoffMultiplier"`
+	RetryableStatusCodes []string `json:"retryableStatusCodes"`
+}
+
+type methodConfig struct {
+	Name        []methodName `json:"name"`
+	RetryPolicy *retryPolicy `json:"retryPolicy,omitempty"`
+}
+
+type serviceConfig struct {
+	MethodConfig []methodConfig `json:"methodConfig"`
+}
+
+// ServiceConfig renders the gRPC service config applying cfg to the
+// idempotent backend RPCs. Only UNAVAILABLE is retried, the backend did not
+// process those calls.
+func ServiceConfig(cfg config.RetryConfig) string {
+	sc := serviceConfig{MethodConfig: []methodConfig{}}
+
+	if cfg.MaxAttempts > 1 {
+		mc := methodConfig{
+			RetryPolicy: &retryPolicy{
+				MaxAttempts:          cfg.MaxAttempts,
+				InitialBackoff:       protoDuration(cfg.InitialBackoff),
+				MaxBackoff:           protoDuration(cfg.MaxBackoff),
+				BackoffMultiplier:    cfg.BackoffMultiplier,
+				RetryableStatusCodes: []string{"UNAVAILABLE"},
+			},
+		}
+		for _, fullMethod := range idempotentMethods {
+			service, method := splitFullMethod(fullMethod)
+			mc.Name = append(mc.Name, methodName{Service: service, Method: method})
+		}
+		sc.MethodConfig = append(sc.MethodConfig, mc)
+	}
+
+	// marshalling these plain structs cannot fail
+	b, _ := json.Marshal(sc)
+	return string(b)
+}
+
+// protoDuration formats d the way the JSON mapping of
+// google.protobuf.Duration expects, e.g. "0.1s".
+func protoDuration(d time.Duration) string {
+	return strconv.FormatFloat(d.Seconds(), 'f', -1, 64) + "s"
+}
+
+// splitFullMethod splits "/v1.appserver.AppserverService/GetById" into its
+// service and method names.
+func splitFullMethod(fullMethod string) (string, string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+	return "unknown", fullMethod
+}
@@ -0,0 +1,131 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"mistapi/src/config"
+	"mistapi/src/protos/v1/appserver"
+	"mistapi/src/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+// flakyAppserverServer fails the first failures calls of every RPC with
+// UNAVAILABLE and counts all attempts.
+type flakyAppserverServer struct {
+	appserver.UnimplementedAppserverServiceServer
+	failures int32
+	calls    atomic.Int32
+}
+
+func (s *flakyAppserverServer) attempt() error {
+	if s.calls.Add(1) <= s.failures {
+		return status.Error(codes.Unavailable, "backend restarting")
+	}
+	return nil
+}
+
+func (s *flakyAppserverServer) GetById(
+	context.Context, *appserver.GetByIdRequest,
+) (*appserver.GetByIdResponse, error) {
+	if err := s.attempt(); err != nil {
+		return nil, err
+	}
+	return &appserver.GetByIdResponse{}, nil
+}
+
+func (s *flakyAppserverServer) Create(
+	context.Context, *appserver.CreateRequest,
+) (*appserver.CreateResponse, error) {
+	if err := s.attempt(); err != nil {
+		return nil, err
+	}
+	return &appserver.CreateResponse{}, nil
+}
+
+func dialFlakyBackend(t *testing.T, srv *flakyAppserverServer, cfg config.RetryConfig) appserver.AppserverServiceClient {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	s := grpc.NewServer()
+	appserver.RegisterAppserverServiceServer(s, srv)
+	go s.Serve(ln)
+	t.Cleanup(s.Stop)
+
+	cc, err := grpc.NewClient(ln.Addr().String(),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultServiceConfig(service.ServiceConfig(cfg)),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { cc.Close() })
+
+	return appserver.NewAppserverServiceClient(cc)
+}
+
+func TestServiceConfig(t *testing.T) {
+	retry := config.RetryConfig{
+		MaxAttempts:       3,
+		InitialBackoff:    time.Millisecond,
+		MaxBackoff:        5 * time.Millisecond,
+		BackoffMultiplier: 2,
+	}
+
+	t.Run("Success:idempotent_calls_are_retried", func(t *testing.T) {
+		// ARRANGE
+		srv := &flakyAppserverServer{failures: 2}
+		client := dialFlakyBackend(t, srv, retry)
+
+		// ACT
+		_, err := client.GetById(context.Background(), &appserver.GetByIdRequest{})
+
+		// ASSERT
+		assert.NoError(t, err)
+		assert.EqualValues(t, 3, srv.calls.Load())
+	})
+
+	t.Run("Error:retries_stop_after_max_attempts", func(t *testing.T) {
+		// ARRANGE
+		srv := &flakyAppserverServer{failures: 5}
+		client := dialFlakyBackend(t, srv, retry)
+
+		// ACT
+		_, err := client.GetById(context.Background(), &appserver.GetByIdRequest{})
+
+		// ASSERT
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		assert.EqualValues(t, 3, srv.calls.Load())
+	})
+
+	t.Run("Error:mutating_calls_are_not_retried", func(t *testing.T) {
+		// ARRANGE
+		srv := &flakyAppserverServer{failures: 1}
+		client := dialFlakyBackend(t, srv, retry)
+
+		// ACT
+		_, err := client.Create(context.Background(), &appserver.CreateRequest{})
+
+		// ASSERT
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		assert.EqualValues(t, 1, srv.calls.Load())
+	})
+
+	t.Run("Success:single_attempt_disables_retries", func(t *testing.T) {
+		// ACT
+		sc := service.ServiceConfig(config.RetryConfig{MaxAttempts: 1})
+
+		// ASSERT
+		var parsed map[string]any
+		require.NoError(t, json.Unmarshal([]byte(sc), &parsed))
+		assert.Empty(t, parsed["methodConfig"])
+	})
+}
@@ -0,0 +1,212 @@
+package service
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log/slog"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"mistapi/src/config"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// TransportCredentials builds the credentials of the backend connection.
+// Certificates and the CA bundle are re-read from disk every ReloadInterval
+// when they change, so rotated files are picked up without a restart. The
+// returned stop function ends the reloading. The backend certificate is
+// verified against cfg.ServerName, or the host of target when it is not set.
+func TransportCredentials(cfg config.BackendTLSConfig, target string) (credentials.TransportCredentials, func(), error) {
+	if cfg.Insecure {
+		slog.Warn("Backend connection is not encrypted, only use this in development")
+		return insecure.NewCredentials(), func() {}, nil
+	}
+
+	serverName := cfg.ServerName
+	if serverName == "" {
+		serverName = targetHost(target)
+	}
+
+	r := &tlsReloader{cfg: cfg, serverName: serverName, modTimes: map[string]time.Time{}}
+	if err := r.load(); err != nil {
+		return nil, nil, err
+	}
+
+	tlsCfg := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: cfg.ServerName,
+	}
+
+	if cfg.CertFile != "" {
+		tlsCfg.GetClientCertificate = r.clientCertificate
+	}
+
+	if cfg.CAFile != "" {
+		if serverName == "" {
+			return nil, nil, fmt.Errorf("backend server name is required with a CA bundle, set server_name")
+		}
+		// The CA pool can change after the connection is created, so the
+		// server certificate is verified against the current pool in
+		// VerifyConnection instead of the static RootCAs.
+		tlsCfg.InsecureSkipVerify = true
+		tlsCfg.VerifyConnection = r.verifyConnection
+	}
+
+	stop := func() {}
+	if cfg.ReloadInterval > 0 && (cfg.CAFile != "" || cfg.CertFile != "") {
+		stop = r.watch(cfg.ReloadInterval)
+	}
+
+	return credentials.NewTLS(tlsCfg), stop, nil
+}
+
+// targetHost returns the host of a gRPC target such as "backend:50051" or
+// "dns:///backend:50051", empty when it has none.
+func targetHost(target string) string {
+	if _, rest, ok := strings.Cut(target, "://"); ok {
+		target = rest[strings.LastIndex(rest, "/")+1:]
+	}
+	if host, _, err := net.SplitHostPort(target); err == nil {
+		return host
+	}
+	return target
+}
+
+type tlsReloader struct {
+	cfg config.BackendTLSConfig
+	// serverName is the name the backend certificate must be valid for, the
+	// handshake leaves ConnectionState.ServerName empty for IP addresses.
+	serverName string
+
+	mu       sync.RWMutex
+	cert     *tls.Certificate
+	roots    *x509.CertPool
+	modTimes map[string]time.Time
+}
+
+// load reads the certificate files and swaps them in only if all of them are
+// valid, so a half written rotation never breaks the connection.
+func (r *tlsReloader) load() error {
+	var cert *tls.Certificate
+	var roots *x509.CertPool
+
+	if r.cfg.CertFile != "" || r.cfg.KeyFile != "" {
+		c, err := tls.LoadX509KeyPair(r.cfg.CertFile, r.cfg.KeyFile)
+		if err != nil {
+			return fmt.Errorf("loading backend client certificate: %w", err)
+		}
+		cert = &c
+	}
+
+	if r.cfg.CAFile != "" {
+		pem, err := os.ReadFile(r.cfg.CAFile)
+		if err != nil {
+			return fmt.Errorf("reading backend CA bundle: %w", err)
+		}
+		roots = x509.NewCertPool()
+		if !roots.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("no certificates found in backend CA bundle %s", r.cfg.CAFile)
+		}
+	}
+
+	modTimes := map[string]time.Time{}
+	for _, f := range r.files() {
+		if info, err := os.Stat(f); err == nil {
+			modTimes[f] = info.ModTime()
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cert, r.roots, r.modTimes = cert, roots, modTimes
+
+	return nil
+}
+
+func (r *tlsReloader) files() []string {
+	files := make([]string, 0, 3)
+	for _, f := range []string{r.cfg.CAFile, r.cfg.CertFile, r.cfg.KeyFile} {
+		if f != "" {
+			files = append(files, f)
+		}
+	}
+	return files
+}
+
+func (r *tlsReloader) changed() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, f := range r.files() {
+		info, err := os.Stat(f)
+		if err != nil {
+			// keep using the loaded files while a rotation is in progress
+			continue
+		}
+		if !info.ModTime().Equal(r.modTimes[f]) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *tlsReloader) watch(interval time.Duration) func() {
+	done := make(chan struct{})
+	ticker := time.NewTicker(interval)
+
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if !r.changed() {
+					continue
+				}
+				if err := r.load(); err != nil {
+					slog.Error("Error reloading backend TLS files, keeping the previous ones", "error", err)
+					continue
+				}
+				slog.Info("Reloaded backend TLS files")
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() { once.Do(func() { close(done) }) }
+}
+
+func (r *tlsReloader) clientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert, nil
+}
+
+func (r *tlsReloader) verifyConnection(cs tls.ConnectionState) error {
+	if len(cs.PeerCertificates) == 0 {
+		return fmt.Errorf("backend did not present a certificate")
+	}
+
+	r.mu.RLock()
+	roots := r.roots
+	r.mu.RUnlock()
+
+	intermediates := x509.NewCertPool()
+	for _, c := range cs.PeerCertificates[1:] {
+		intermediates.AddCert(c)
+	}
+
+	_, err := cs.PeerCertificates[0].Verify(x509.VerifyOptions{
+		DNSName:       r.serverName,
+		Roots:         roots,
+		Intermediates: intermediates,
+	})
+	return err
+}
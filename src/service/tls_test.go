@@ -0,0 +1,235 @@
+package service_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"mistapi/src/config"
+	"mistapi/src/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return &testCA{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue returns a PEM certificate and key signed by the CA.
+func (ca *testCA) issue(t *testing.T, usage x509.ExtKeyUsage) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+}
+
+func (ca *testCA) serverCertificate(t *testing.T) *tls.Certificate {
+	certPEM, keyPEM := ca.issue(t, x509.ExtKeyUsageServerAuth)
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	require.NoError(t, err)
+	return &cert
+}
+
+func writeFile(t *testing.T, path string, content []byte) {
+	require.NoError(t, os.WriteFile(path, content, 0o600))
+}
+
+// startTLSBackend runs a health server requiring client certificates signed
+// by clientCA. The served certificate can be swapped while it runs.
+func startTLSBackend(t *testing.T, serverCert *atomic.Pointer[tls.Certificate], clientCA *testCA) string {
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(clientCA.cert)
+
+	creds := credentials.NewTLS(&tls.Config{
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return serverCert.Load(), nil
+		},
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  clientCAs,
+	})
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	srv := grpc.NewServer(grpc.Creds(creds))
+	healthpb.RegisterHealthServer(srv, health.NewServer())
+	go srv.Serve(ln)
+	t.Cleanup(srv.Stop)
+
+	return ln.Addr().String()
+}
+
+func checkHealth(t *testing.T, addr string, creds credentials.TransportCredentials) error {
+	cc, err := grpc.NewClient(addr, grpc.WithTransportCredentials(creds))
+	require.NoError(t, err)
+	defer cc.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	_, err = healthpb.NewHealthClient(cc).Check(ctx, &healthpb.HealthCheckRequest{})
+	return err
+}
+
+func TestTransportCredentials(t *testing.T) {
+	dir := t.TempDir()
+	serverCA, clientCA := newTestCA(t), newTestCA(t)
+
+	var serverCert atomic.Pointer[tls.Certificate]
+	serverCert.Store(serverCA.serverCertificate(t))
+	addr := startTLSBackend(t, &serverCert, clientCA)
+
+	caFile := filepath.Join(dir, "ca.pem")
+	certFile := filepath.Join(dir, "client.pem")
+	keyFile := filepath.Join(dir, "client-key.pem")
+	writeFile(t, caFile, serverCA.pem)
+	clientCert, clientKey := clientCA.issue(t, x509.ExtKeyUsageClientAuth)
+	writeFile(t, certFile, clientCert)
+	writeFile(t, keyFile, clientKey)
+
+	t.Run("Success:mutual_tls_handshake", func(t *testing.T) {
+		// ARRANGE
+		creds, stop, err := service.TransportCredentials(config.BackendTLSConfig{
+			CAFile: caFile, CertFile: certFile, KeyFile: keyFile, ServerName: "localhost",
+		}, addr)
+		require.NoError(t, err)
+		defer stop()
+
+		// ACT
+		err = checkHealth(t, addr, creds)
+
+		// ASSERT
+		assert.NoError(t, err)
+	})
+
+	t.Run("Error:missing_client_certificate_is_rejected", func(t *testing.T) {
+		// ARRANGE
+		creds, stop, err := service.TransportCredentials(config.BackendTLSConfig{
+			CAFile: caFile, ServerName: "localhost",
+		}, addr)
+		require.NoError(t, err)
+		defer stop()
+
+		// ACT
+		err = checkHealth(t, addr, creds)
+
+		// ASSERT
+		assert.Error(t, err)
+	})
+
+	t.Run("Error:ip_addressed_backend_is_verified_against_its_address", func(t *testing.T) {
+		// ARRANGE
+		// the certificate is only valid for localhost, not for 127.0.0.1
+		creds, stop, err := service.TransportCredentials(config.BackendTLSConfig{
+			CAFile: caFile, CertFile: certFile, KeyFile: keyFile,
+		}, addr)
+		require.NoError(t, err)
+		defer stop()
+
+		// ACT
+		err = checkHealth(t, addr, creds)
+
+		// ASSERT
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "127.0.0.1")
+	})
+
+	t.Run("Error:ca_bundle_without_server_name", func(t *testing.T) {
+		// ACT
+		_, _, err := service.TransportCredentials(config.BackendTLSConfig{CAFile: caFile}, "")
+
+		// ASSERT
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "server name is required")
+	})
+
+	t.Run("Error:invalid_ca_bundle", func(t *testing.T) {
+		// ARRANGE
+		badCA := filepath.Join(dir, "bad-ca.pem")
+		writeFile(t, badCA, []byte("not a certificate"))
+
+		// ACT
+		_, _, err := service.TransportCredentials(config.BackendTLSConfig{CAFile: badCA}, addr)
+
+		// ASSERT
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no certificates found")
+	})
+
+	t.Run("Success:rotated_ca_bundle_is_reloaded", func(t *testing.T) {
+		// ARRANGE
+		creds, stop, err := service.TransportCredentials(config.BackendTLSConfig{
+			CAFile: caFile, CertFile: certFile, KeyFile: keyFile, ServerName: "localhost",
+			ReloadInterval: 10 * time.Millisecond,
+		}, addr)
+		require.NoError(t, err)
+		defer stop()
+
+		// ACT
+		rotatedCA := newTestCA(t)
+		serverCert.Store(rotatedCA.serverCertificate(t))
+		errBeforeReload := checkHealth(t, addr, creds)
+
+		writeFile(t, caFile, rotatedCA.pem)
+
+		// ASSERT
+		assert.Error(t, errBeforeReload)
+		assert.Eventually(t, func() bool {
+			return checkHealth(t, addr, creds) == nil
+		}, 5*time.Second, 50*time.Millisecond)
+	})
+}
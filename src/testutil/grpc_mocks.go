@@ -111,6 +111,13 @@ func (m *MockAppserverService) Delete(
 	return returnIfError[*appserver.DeleteResponse](args, 1)
 }
 
+func (m *MockAppserverService) Update(
+	ctx context.Context, in *appserver.UpdateRequest, opts ...grpc.CallOption,
+) (*appserver.UpdateResponse, error) {
+	args := m.Called(ctx, in)
+	return returnIfError[*appserver.UpdateResponse](args, 1)
+}
+
 type MockAppserverPermissionService struct{ mock.Mock }
 
 // ----- APPSERVER ROLE -----
@@ -137,6 +144,13 @@ func (m *MockAppserverRoleService) Delete(
 	return returnIfError[*appserver_role.DeleteResponse](args, 1)
 }
 
+func (m *MockAppserverRoleService) Update(
+	ctx context.Context, in *appserver_role.UpdateRequest, opts ...grpc.CallOption,
+) (*appserver_role.UpdateResponse, error) {
+	args := m.Called(ctx, in)
+	return returnIfError[*appserver_role.UpdateResponse](args, 1)
+}
+
 // ----- APPSERVER ROLE SUB -----
 type MockAppserverRoleSubService struct{ mock.Mock }
 
@@ -192,6 +206,16 @@ func (m *MockAppserverSubService) Delete(
 	return returnIfError[*appserver_sub.DeleteResponse](args, 1)
 }
 
+// ----- APPUSER -----
+type MockAppuserService struct{ mock.Mock }
+
+func (m *MockAppuserService) Create(
+	ctx context.Context, in *appuser.CreateRequest, opts ...grpc.CallOption,
+) (*appuser.CreateResponse, error) {
+	args := m.Called(ctx, in)
+	return returnIfError[*appuser.CreateResponse](args, 1)
+}
+
 // ----- CHANNEL -----
 type MockChannelService struct{ mock.Mock }
 
@@ -223,6 +247,13 @@ func (m *MockChannelService) Delete(
 	return returnIfError[*channel.DeleteResponse](args, 1)
 }
 
+func (m *MockChannelService) Update(
+	ctx context.Context, in *channel.UpdateRequest, opts ...grpc.CallOption,
+) (*channel.UpdateResponse, error) {
+	args := m.Called(ctx, in)
+	return returnIfError[*channel.UpdateResponse](args, 1)
+}
+
 // ----- CHANNEL ROLE -----
 type MockChannelRoleService struct{ mock.Mock }
 
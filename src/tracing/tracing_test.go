@@ -0,0 +1,64 @@
+package tracing_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"mistapi/src/config"
+	"mistapi/src/tracing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestSetup(t *testing.T) {
+	t.Run("Success:none_exporter_keeps_tracing_disabled", func(t *testing.T) {
+		// ACT
+		shutdown, err := tracing.Setup(context.Background(), config.TracingConfig{Exporter: "none"})
+
+		// ASSERT
+		require.NoError(t, err)
+		assert.NoError(t, shutdown(context.Background()))
+	})
+
+	t.Run("Error:unknown_exporter", func(t *testing.T) {
+		// ACT
+		_, err := tracing.Setup(context.Background(), config.TracingConfig{Exporter: "zipkin"})
+
+		// ASSERT
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unknown tracing exporter")
+	})
+}
+
+func TestMiddleware(t *testing.T) {
+	// ARRANGE
+	recorder := tracetest.NewSpanRecorder()
+	original := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(original) })
+
+	r := chi.NewRouter()
+	r.Use(tracing.Middleware)
+	r.Route("/api/", func(r chi.Router) {
+		r.Get("/v1/appservers/{id}", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		})
+	})
+
+	// ACT
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/v1/appservers/123", nil))
+
+	// ASSERT
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	assert.Equal(t, "GET /api/v1/appservers/{id}", spans[0].Name())
+	assert.Contains(t, spans[0].Attributes(), attribute.String("http.route", "/api/v1/appservers/{id}"))
+}
@@ -1,21 +1,33 @@
 package types
 
+import "time"
+
 type Appserver struct {
-	ID      string `json:"id"`
-	Name    string `json:"name"`
-	IsOwner bool   `json:"is_owner"`
+	ID        string     `json:"id"`
+	Name      string     `json:"name"`
+	IsOwner   bool       `json:"is_owner"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
 }
 
 type AppserverDetail struct {
-	ID       string          `json:"id"`
-	Name     string          `json:"name"`
-	IsOwner  bool            `json:"is_owner"`
-	Roles    []AppserverRole `json:"roles"`
-	Channels []Channel       `json:"channels"`
+	ID        string          `json:"id"`
+	Name      string          `json:"name"`
+	IsOwner   bool            `json:"is_owner"`
+	UpdatedAt *time.Time      `json:"updated_at,omitempty"`
+	Roles     []AppserverRole `json:"roles"`
+	Channels  []Channel       `json:"channels"`
 }
 
 type AppserverCreate struct {
-	Name string `json:"name"`
+	Name string `json:"name" validate:"required"`
+}
+
+// AppserverPatch documents the JSON merge patch accepted when updating an
+// appserver. UpdatedAt is the value last read by the client; the update is
+// rejected when the appserver changed since.
+type AppserverPatch struct {
+	Name      string     `json:"name,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
 }
 
 type AppserverAndSub struct {
@@ -1,12 +1,39 @@
 package types
 
+import "time"
+
 type AppserverRole struct {
-	ID          string `json:"id"`
-	Name        string `json:"name"`
-	AppserverId string `json:"appserver_id"`
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	AppserverId string     `json:"appserver_id"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+	RolePermissions
 }
 
 type AppserverRoleCreate struct {
-	Name        string `json:"name"`
-	AppserverId string `json:"appserver_id"`
+	Name        string `json:"name" validate:"required"`
+	AppserverId string `json:"appserver_id" validate:"required"`
+	RolePermissions
+}
+
+// RolePermissions carries the permission masks of a role both as raw
+// integers and as lists of permission names. On create both forms may be
+// given and are combined.
+type RolePermissions struct {
+	AppserverPermissionMask int64    `json:"appserver_permission_mask"`
+	ChannelPermissionMask   int64    `json:"channel_permission_mask"`
+	SubPermissionMask       int64    `json:"sub_permission_mask"`
+	AppserverPermissions    []string `json:"appserver_permissions" example:"manage_channels"`
+	ChannelPermissions      []string `json:"channel_permissions" example:"view_channel,send_messages"`
+	SubPermissions          []string `json:"sub_permissions" example:"kick_members"`
+}
+
+// AppserverRolePatch documents the JSON merge patch accepted when updating a
+// role. A permission list replaces the mask of its scope and is combined with
+// the raw mask when both are given.
+type AppserverRolePatch struct {
+	AppserverId string     `json:"appserver_id"`
+	Name        string     `json:"name,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+	RolePermissions
 }
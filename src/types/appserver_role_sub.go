@@ -8,8 +8,8 @@ type AppserverRoleSub struct {
 }
 
 type AppserverRoleSubCreate struct {
-	AppuserId       string `json:"appuser_id"`
-	AppserverRoleId string `json:"appserver_role_id"`
-	AppserverId     string `json:"appserver_id"`
-	AppserverSubId  string `json:"appserver_sub_id"`
+	AppuserId       string `json:"appuser_id" validate:"required"`
+	AppserverRoleId string `json:"appserver_role_id" validate:"required"`
+	AppserverId     string `json:"appserver_id" validate:"required"`
+	AppserverSubId  string `json:"appserver_sub_id" validate:"required"`
 }
@@ -7,5 +7,5 @@ type AppserverSub struct {
 }
 
 type AppserverSubCreate struct {
-	AppserverId string `json:"appserver_id"`
+	AppserverId string `json:"appserver_id" validate:"required"`
 }
@@ -1,13 +1,37 @@
 package types
 
+import "time"
+
 type Channel struct {
-	ID          string `json:"id"`
-	Name        string `json:"name"`
-	AppserverId string `json:"appserver_id"`
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	AppserverId string     `json:"appserver_id"`
+	IsPrivate   bool       `json:"is_private"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
 }
 
 type ChannelCreate struct {
-	Name        string `json:"name"`
-	AppserverId string `json:"appserver_id"`
+	Name        string `json:"name" validate:"required"`
+	AppserverId string `json:"appserver_id" validate:"required"`
 	IsPrivate   bool   `json:"is_private,omitempty"`
 }
+
+type ChannelDetail struct {
+	ID          string        `json:"id"`
+	Name        string        `json:"name"`
+	AppserverId string        `json:"appserver_id"`
+	IsPrivate   bool          `json:"is_private"`
+	CreatedAt   *time.Time    `json:"created_at,omitempty"`
+	UpdatedAt   *time.Time    `json:"updated_at,omitempty"`
+	Roles       []ChannelRole `json:"roles"`
+}
+
+// ChannelPatch documents the JSON merge patch accepted when updating a
+// channel. AppserverId identifies the server of the channel and cannot be
+// changed.
+type ChannelPatch struct {
+	AppserverId string     `json:"appserver_id"`
+	Name        string     `json:"name,omitempty"`
+	IsPrivate   *bool      `json:"is_private,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+}
@@ -8,7 +8,7 @@ type ChannelRole struct {
 }
 
 type ChannelRoleCreate struct {
-	ChannelId       string `json:"channel_id"`
-	AppserverId     string `json:"appserver_id"`
-	AppserverRoleId string `json:"appserver_role_id"`
+	ChannelId       string `json:"channel_id" validate:"required"`
+	AppserverId     string `json:"appserver_id" validate:"required"`
+	AppserverRoleId string `json:"appserver_role_id" validate:"required"`
 }
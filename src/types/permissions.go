@@ -0,0 +1,27 @@
+package types
+
+type PermissionGrant struct {
+	Permission string `json:"permission"`
+	// RoleIds are the roles granting the permission, empty for owners.
+	RoleIds []string `json:"role_ids"`
+}
+
+type ResolvedPermissions struct {
+	Mask   int64             `json:"mask"`
+	Grants []PermissionGrant `json:"grants"`
+}
+
+type ChannelPermissions struct {
+	ChannelId string `json:"channel_id"`
+	IsPrivate bool   `json:"is_private"`
+	ResolvedPermissions
+}
+
+type MemberPermissions struct {
+	AppserverId string               `json:"appserver_id"`
+	AppuserId   string               `json:"appuser_id"`
+	IsOwner     bool                 `json:"is_owner"`
+	Appserver   ResolvedPermissions  `json:"appserver"`
+	Sub         ResolvedPermissions  `json:"sub"`
+	Channels    []ChannelPermissions `json:"channels"`
+}
@@ -0,0 +1,12 @@
+package types
+
+import "time"
+
+type RevocationCreate struct {
+	// Jti revokes a single token.
+	Jti string `json:"jti,omitempty"`
+	// UserId revokes every token of the user issued before IssuedBefore,
+	// which defaults to now.
+	UserId       string     `json:"user_id,omitempty"`
+	IssuedBefore *time.Time `json:"issued_before,omitempty"`
+}